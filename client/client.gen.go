@@ -1667,13 +1667,13 @@ type AnnotationLayerRestApiPut struct {
 
 // AnnotationRestApiGet defines model for AnnotationRestApi.get.
 type AnnotationRestApiGet struct {
-	EndDttm      *time.Time                          `json:"end_dttm"`
+	EndDttm      *SupersetTime                       `json:"end_dttm"`
 	Id           *int32                              `json:"id,omitempty"`
 	JsonMetadata *string                             `json:"json_metadata"`
 	Layer        AnnotationRestApiGetAnnotationLayer `json:"layer"`
 	LongDescr    *string                             `json:"long_descr"`
 	ShortDescr   *string                             `json:"short_descr"`
-	StartDttm    *time.Time                          `json:"start_dttm"`
+	StartDttm    *SupersetTime                       `json:"start_dttm"`
 }
 
 // AnnotationRestApiGetAnnotationLayer defines model for AnnotationRestApi.get.AnnotationLayer.
@@ -2273,7 +2273,7 @@ type ChartRestApiPostDatasourceType string
 // ChartRestApiPut defines model for ChartRestApi.put.
 type ChartRestApiPut struct {
 	// Duration (in seconds) of the caching timeout for this chart. Note this defaults to the datasource/table timeout if undefined.
-	CacheTimeout *int32 `json:"cache_timeout,omitempty"`
+	CacheTimeout *int32 `json:"cache_timeout"`
 
 	// Details of the certification
 	CertificationDetails *string `json:"certification_details,omitempty"`
@@ -2333,7 +2333,7 @@ type CssTemplateRestApiGetList struct {
 	ChangedBy               *CssTemplateRestApiGetListUser  `json:"changed_by,omitempty"`
 	ChangedOnDeltaHumanized *interface{}                    `json:"changed_on_delta_humanized,omitempty"`
 	CreatedBy               *CssTemplateRestApiGetListUser1 `json:"created_by,omitempty"`
-	CreatedOn               *time.Time                      `json:"created_on"`
+	CreatedOn               *SupersetTime                   `json:"created_on"`
 	Css                     *string                         `json:"css"`
 	Id                      *int32                          `json:"id,omitempty"`
 	TemplateName            *string                         `json:"template_name"`
@@ -2613,10 +2613,10 @@ type DatabaseRelatedCharts struct {
 
 // DatabaseRelatedDashboard defines model for DatabaseRelatedDashboard.
 type DatabaseRelatedDashboard struct {
-	Id           *int32                  `json:"id,omitempty"`
-	JsonMetadata *map[string]interface{} `json:"json_metadata,omitempty"`
-	Slug         *string                 `json:"slug,omitempty"`
-	Title        *string                 `json:"title,omitempty"`
+	Id           *int32  `json:"id,omitempty"`
+	JsonMetadata *string `json:"json_metadata"`
+	Slug         *string `json:"slug,omitempty"`
+	Title        *string `json:"title,omitempty"`
 }
 
 // DatabaseRelatedDashboards defines model for DatabaseRelatedDashboards.
@@ -3055,10 +3055,10 @@ type DatasetRelatedCharts struct {
 
 // DatasetRelatedDashboard defines model for DatasetRelatedDashboard.
 type DatasetRelatedDashboard struct {
-	Id           *int32                  `json:"id,omitempty"`
-	JsonMetadata *map[string]interface{} `json:"json_metadata,omitempty"`
-	Slug         *string                 `json:"slug,omitempty"`
-	Title        *string                 `json:"title,omitempty"`
+	Id           *int32  `json:"id,omitempty"`
+	JsonMetadata *string `json:"json_metadata"`
+	Slug         *string `json:"slug,omitempty"`
+	Title        *string `json:"title,omitempty"`
 }
 
 // DatasetRelatedDashboards defines model for DatasetRelatedDashboards.
@@ -3265,11 +3265,11 @@ type EmbeddedDashboardConfig struct {
 
 // EmbeddedDashboardResponseSchema defines model for EmbeddedDashboardResponseSchema.
 type EmbeddedDashboardResponseSchema struct {
-	AllowedDomains *[]string  `json:"allowed_domains,omitempty"`
-	ChangedBy      *User      `json:"changed_by,omitempty"`
-	ChangedOn      *time.Time `json:"changed_on,omitempty"`
-	DashboardId    *string    `json:"dashboard_id,omitempty"`
-	Uuid           *string    `json:"uuid,omitempty"`
+	AllowedDomains *[]string     `json:"allowed_domains,omitempty"`
+	ChangedBy      *User         `json:"changed_by,omitempty"`
+	ChangedOn      *SupersetTime `json:"changed_on,omitempty"`
+	DashboardId    *string       `json:"dashboard_id,omitempty"`
+	Uuid           *string       `json:"uuid,omitempty"`
 }
 
 // ExploreContextSchema defines model for ExploreContextSchema.
@@ -3468,6 +3468,12 @@ type RelatedResponseSchema struct {
 
 // RelatedResultResponse defines model for RelatedResultResponse.
 type RelatedResultResponse struct {
+	// Extra fields of the related item, such as the email of users
+	Extra *struct {
+		Active *bool   `json:"active,omitempty"`
+		Email  *string `json:"email,omitempty"`
+	} `json:"extra,omitempty"`
+
 	// The related item string representation
 	Text *string `json:"text,omitempty"`
 
@@ -3477,11 +3483,11 @@ type RelatedResultResponse struct {
 
 // ReportExecutionLogRestApiGet defines model for ReportExecutionLogRestApi.get.
 type ReportExecutionLogRestApiGet struct {
-	EndDttm       *time.Time          `json:"end_dttm"`
+	EndDttm       *SupersetTime       `json:"end_dttm"`
 	ErrorMessage  *string             `json:"error_message"`
 	Id            *int32              `json:"id,omitempty"`
-	ScheduledDttm time.Time           `json:"scheduled_dttm"`
-	StartDttm     *time.Time          `json:"start_dttm"`
+	ScheduledDttm SupersetTime        `json:"scheduled_dttm"`
+	StartDttm     *SupersetTime       `json:"start_dttm"`
 	State         string              `json:"state"`
 	Uuid          *openapi_types.UUID `json:"uuid"`
 	Value         *float32            `json:"value"`
@@ -3490,11 +3496,11 @@ type ReportExecutionLogRestApiGet struct {
 
 // ReportExecutionLogRestApiGetList defines model for ReportExecutionLogRestApi.get_list.
 type ReportExecutionLogRestApiGetList struct {
-	EndDttm       *time.Time          `json:"end_dttm"`
+	EndDttm       *SupersetTime       `json:"end_dttm"`
 	ErrorMessage  *string             `json:"error_message"`
 	Id            *int32              `json:"id,omitempty"`
-	ScheduledDttm time.Time           `json:"scheduled_dttm"`
-	StartDttm     *time.Time          `json:"start_dttm"`
+	ScheduledDttm SupersetTime        `json:"scheduled_dttm"`
+	StartDttm     *SupersetTime       `json:"start_dttm"`
 	State         string              `json:"state"`
 	Uuid          *openapi_types.UUID `json:"uuid"`
 	Value         *float32            `json:"value"`
@@ -3519,33 +3525,33 @@ type ReportRecipientConfigJSON struct {
 
 // ReportScheduleRestApiGet defines model for ReportScheduleRestApi.get.
 type ReportScheduleRestApiGet struct {
-	Active              *bool                                    `json:"active"`
-	Chart               *ReportScheduleRestApiGetSlice           `json:"chart,omitempty"`
-	ContextMarkdown     *string                                  `json:"context_markdown"`
-	CreationMethod      *string                                  `json:"creation_method"`
-	Crontab             string                                   `json:"crontab"`
-	Dashboard           *ReportScheduleRestApiGetDashboard       `json:"dashboard,omitempty"`
-	Database            *ReportScheduleRestApiGetDatabase        `json:"database,omitempty"`
-	Description         *string                                  `json:"description"`
-	Extra               *interface{}                             `json:"extra,omitempty"`
-	ForceScreenshot     *bool                                    `json:"force_screenshot"`
-	GracePeriod         *int32                                   `json:"grace_period"`
-	Id                  *int32                                   `json:"id,omitempty"`
-	LastEvalDttm        *time.Time                               `json:"last_eval_dttm"`
-	LastState           *string                                  `json:"last_state"`
-	LastValue           *float32                                 `json:"last_value"`
-	LastValueRowJson    *string                                  `json:"last_value_row_json"`
-	LogRetention        *int32                                   `json:"log_retention"`
-	Name                string                                   `json:"name"`
-	Owners              *ReportScheduleRestApiGetUser            `json:"owners,omitempty"`
-	Recipients          ReportScheduleRestApiGetReportRecipients `json:"recipients"`
-	ReportFormat        *string                                  `json:"report_format"`
-	Sql                 *string                                  `json:"sql"`
-	Timezone            *string                                  `json:"timezone,omitempty"`
-	Type                string                                   `json:"type"`
-	ValidatorConfigJson *string                                  `json:"validator_config_json"`
-	ValidatorType       *string                                  `json:"validator_type"`
-	WorkingTimeout      *int32                                   `json:"working_timeout"`
+	Active              *bool                                      `json:"active"`
+	Chart               *ReportScheduleRestApiGetSlice             `json:"chart,omitempty"`
+	ContextMarkdown     *string                                    `json:"context_markdown"`
+	CreationMethod      *string                                    `json:"creation_method"`
+	Crontab             string                                     `json:"crontab"`
+	Dashboard           *ReportScheduleRestApiGetDashboard         `json:"dashboard,omitempty"`
+	Database            *ReportScheduleRestApiGetDatabase          `json:"database,omitempty"`
+	Description         *string                                    `json:"description"`
+	Extra               *interface{}                               `json:"extra,omitempty"`
+	ForceScreenshot     *bool                                      `json:"force_screenshot"`
+	GracePeriod         *int32                                     `json:"grace_period"`
+	Id                  *int32                                     `json:"id,omitempty"`
+	LastEvalDttm        *SupersetTime                              `json:"last_eval_dttm"`
+	LastState           *string                                    `json:"last_state"`
+	LastValue           *float32                                   `json:"last_value"`
+	LastValueRowJson    *string                                    `json:"last_value_row_json"`
+	LogRetention        *int32                                     `json:"log_retention"`
+	Name                string                                     `json:"name"`
+	Owners              *[]ReportScheduleRestApiGetUser            `json:"owners,omitempty"`
+	Recipients          []ReportScheduleRestApiGetReportRecipients `json:"recipients"`
+	ReportFormat        *string                                    `json:"report_format"`
+	Sql                 *string                                    `json:"sql"`
+	Timezone            *string                                    `json:"timezone,omitempty"`
+	Type                string                                     `json:"type"`
+	ValidatorConfigJson *string                                    `json:"validator_config_json"`
+	ValidatorType       *string                                    `json:"validator_type"`
+	WorkingTimeout      *int32                                     `json:"working_timeout"`
 }
 
 // ReportScheduleRestApiGetDashboard defines model for ReportScheduleRestApi.get.Dashboard.
@@ -3812,7 +3818,7 @@ type SavedQueryRestApiGetUser struct {
 type SavedQueryRestApiGetList struct {
 	ChangedOnDeltaHumanized *interface{}                      `json:"changed_on_delta_humanized,omitempty"`
 	CreatedBy               *SavedQueryRestApiGetListUser     `json:"created_by,omitempty"`
-	CreatedOn               *time.Time                        `json:"created_on"`
+	CreatedOn               *SupersetTime                     `json:"created_on"`
 	Database                *SavedQueryRestApiGetListDatabase `json:"database,omitempty"`
 	DbId                    *int32                            `json:"db_id"`
 	Description             *string                           `json:"description"`
@@ -15371,7 +15377,7 @@ type GetApiV1AnnotationLayerResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// A list of item ids, useful when you don't know the column id
-		Ids          *[]string `json:"ids,omitempty"`
+		Ids          *[]int32 `json:"ids,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -15582,7 +15588,7 @@ type GetApiV1AnnotationLayerPkResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// The item id
-		Id           *string `json:"id,omitempty"`
+		Id           *int32 `json:"id,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -15709,7 +15715,7 @@ type GetApiV1AnnotationLayerPkAnnotationResponse struct {
 		Count *float32 `json:"count,omitempty"`
 
 		// A list of annotation ids
-		Ids *[]string `json:"ids,omitempty"`
+		Ids *[]int32 `json:"ids,omitempty"`
 
 		// The result from the get list query
 		Result *[]AnnotationRestApiGetList `json:"result,omitempty"`
@@ -15748,8 +15754,8 @@ type PostApiV1AnnotationLayerPkAnnotationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON201      *struct {
-		Id     *float32               `json:"id,omitempty"`
-		Result *AnnotationRestApiPost `json:"result,omitempty"`
+		Id     *float32                `json:"id,omitempty"`
+		Result *map[string]interface{} `json:"result,omitempty"`
 	}
 	JSON400 *struct {
 		Message *string `json:"message,omitempty"`
@@ -15819,7 +15825,7 @@ type GetApiV1AnnotationLayerPkAnnotationAnnotationIdResponse struct {
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		// The item id
-		Id     *string               `json:"id,omitempty"`
+		Id     *int32                `json:"id,omitempty"`
 		Result *AnnotationRestApiGet `json:"result,omitempty"`
 	}
 	JSON400 *struct {
@@ -15859,8 +15865,8 @@ type PutApiV1AnnotationLayerPkAnnotationAnnotationIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Id     *float32              `json:"id,omitempty"`
-		Result *AnnotationRestApiPut `json:"result,omitempty"`
+		Id     *float32                `json:"id,omitempty"`
+		Result *map[string]interface{} `json:"result,omitempty"`
 	}
 	JSON400 *struct {
 		Message *string `json:"message,omitempty"`
@@ -16762,7 +16768,7 @@ type GetApiV1CssTemplateResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// A list of item ids, useful when you don't know the column id
-		Ids          *[]string `json:"ids,omitempty"`
+		Ids          *[]int32 `json:"ids,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -16814,7 +16820,7 @@ type PostApiV1CssTemplateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON201      *struct {
-		Id     *string                 `json:"id,omitempty"`
+		Id     *float32                `json:"id,omitempty"`
 		Result *CssTemplateRestApiPost `json:"result,omitempty"`
 	}
 	JSON400 *struct {
@@ -16973,7 +16979,7 @@ type GetApiV1CssTemplatePkResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// The item id
-		Id           *string `json:"id,omitempty"`
+		Id           *int32 `json:"id,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -17426,24 +17432,34 @@ func (r GetApiV1DashboardRelatedColumnNameResponse) StatusCode() int {
 type GetApiV1DashboardDashboardIdFiltersetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]struct {
-		// A description field of the filter set
-		Description *string `json:"description,omitempty"`
+	JSON200      *struct {
+		// The total record count on the backend
+		Count *float32 `json:"count,omitempty"`
 
-		// metadata of the filter set
-		JsonMetadata *string `json:"json_metadata,omitempty"`
+		// A list of filter set ids
+		Ids    *[]int32 `json:"ids,omitempty"`
+		Result *[]struct {
+			DashboardId *int `json:"dashboard_id,omitempty"`
 
-		// Name of the Filter set
-		Name *string `json:"name,omitempty"`
+			// A description field of the filter set
+			Description *string `json:"description"`
+			Id          *int    `json:"id,omitempty"`
 
-		// A description field of the filter set
-		OwnerId *int `json:"owner_id,omitempty"`
+			// metadata of the filter set
+			JsonMetadata *string `json:"json_metadata,omitempty"`
 
-		// the Type of the owner ( Dashboard/User)
-		OwnerType *int `json:"owner_type,omitempty"`
+			// Name of the Filter set
+			Name *string `json:"name,omitempty"`
 
-		// JSON schema defining the needed parameters
-		Parameters *interface{} `json:"parameters,omitempty"`
+			// The id of the dashboard or user that owns the filter set
+			OwnerId *int `json:"owner_id,omitempty"`
+
+			// the Type of the owner ( Dashboard/User)
+			OwnerType *string `json:"owner_type,omitempty"`
+
+			// JSON schema defining the needed parameters
+			Params *interface{} `json:"params,omitempty"`
+		} `json:"result,omitempty"`
 	}
 	JSON400 *struct {
 		Message *string `json:"message,omitempty"`
@@ -20543,7 +20559,7 @@ type GetApiV1ReportPkResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// The item id
-		Id           *string `json:"id,omitempty"`
+		Id           *int32 `json:"id,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -20640,7 +20656,7 @@ type GetApiV1ReportPkLogResponse struct {
 		Count *float32 `json:"count,omitempty"`
 
 		// A list of log ids
-		Ids *[]string `json:"ids,omitempty"`
+		Ids *[]int32 `json:"ids,omitempty"`
 
 		// The result from the get list query
 		Result *[]ReportExecutionLogRestApiGetList `json:"result,omitempty"`
@@ -20680,7 +20696,7 @@ type GetApiV1ReportPkLogLogIdResponse struct {
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		// The log id
-		Id     *string                       `json:"id,omitempty"`
+		Id     *int32                        `json:"id,omitempty"`
 		Result *ReportExecutionLogRestApiGet `json:"result,omitempty"`
 	}
 	JSON400 *struct {
@@ -20764,7 +20780,7 @@ type GetApiV1SavedQueryResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// A list of item ids, useful when you don't know the column id
-		Ids          *[]string `json:"ids,omitempty"`
+		Ids          *[]int32 `json:"ids,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -20816,7 +20832,7 @@ type PostApiV1SavedQueryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON201      *struct {
-		Id     *string                `json:"id,omitempty"`
+		Id     *float32               `json:"id,omitempty"`
 		Result *SavedQueryRestApiPost `json:"result,omitempty"`
 	}
 	JSON400 *struct {
@@ -21078,7 +21094,7 @@ type GetApiV1SavedQueryPkResponse struct {
 		} `json:"description_columns,omitempty"`
 
 		// The item id
-		Id           *string `json:"id,omitempty"`
+		Id           *int32 `json:"id,omitempty"`
 		LabelColumns *struct {
 			// The label for the column name. Will be translated by babel
 			ColumnName *string `json:"column_name,omitempty"`
@@ -23242,7 +23258,7 @@ func ParseGetApiV1AnnotationLayerResponse(rsp *http.Response) (*GetApiV1Annotati
 			} `json:"description_columns,omitempty"`
 
 			// A list of item ids, useful when you don't know the column id
-			Ids          *[]string `json:"ids,omitempty"`
+			Ids          *[]int32 `json:"ids,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -23587,7 +23603,7 @@ func ParseGetApiV1AnnotationLayerPkResponse(rsp *http.Response) (*GetApiV1Annota
 			} `json:"description_columns,omitempty"`
 
 			// The item id
-			Id           *string `json:"id,omitempty"`
+			Id           *int32 `json:"id,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -23804,7 +23820,7 @@ func ParseGetApiV1AnnotationLayerPkAnnotationResponse(rsp *http.Response) (*GetA
 			Count *float32 `json:"count,omitempty"`
 
 			// A list of annotation ids
-			Ids *[]string `json:"ids,omitempty"`
+			Ids *[]int32 `json:"ids,omitempty"`
 
 			// The result from the get list query
 			Result *[]AnnotationRestApiGetList `json:"result,omitempty"`
@@ -23871,8 +23887,8 @@ func ParsePostApiV1AnnotationLayerPkAnnotationResponse(rsp *http.Response) (*Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			Id     *float32               `json:"id,omitempty"`
-			Result *AnnotationRestApiPost `json:"result,omitempty"`
+			Id     *float32                `json:"id,omitempty"`
+			Result *map[string]interface{} `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -23992,7 +24008,7 @@ func ParseGetApiV1AnnotationLayerPkAnnotationAnnotationIdResponse(rsp *http.Resp
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			// The item id
-			Id     *string               `json:"id,omitempty"`
+			Id     *int32                `json:"id,omitempty"`
 			Result *AnnotationRestApiGet `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24066,8 +24082,8 @@ func ParsePutApiV1AnnotationLayerPkAnnotationAnnotationIdResponse(rsp *http.Resp
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Id     *float32              `json:"id,omitempty"`
-			Result *AnnotationRestApiPut `json:"result,omitempty"`
+			Id     *float32                `json:"id,omitempty"`
+			Result *map[string]interface{} `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -25605,7 +25621,7 @@ func ParseGetApiV1CssTemplateResponse(rsp *http.Response) (*GetApiV1CssTemplateR
 			} `json:"description_columns,omitempty"`
 
 			// A list of item ids, useful when you don't know the column id
-			Ids          *[]string `json:"ids,omitempty"`
+			Ids          *[]int32 `json:"ids,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -25685,7 +25701,7 @@ func ParsePostApiV1CssTemplateResponse(rsp *http.Response) (*PostApiV1CssTemplat
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			Id     *string                 `json:"id,omitempty"`
+			Id     *float32                `json:"id,omitempty"`
 			Result *CssTemplateRestApiPost `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25950,7 +25966,7 @@ func ParseGetApiV1CssTemplatePkResponse(rsp *http.Response) (*GetApiV1CssTemplat
 			} `json:"description_columns,omitempty"`
 
 			// The item id
-			Id           *string `json:"id,omitempty"`
+			Id           *int32 `json:"id,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -26735,24 +26751,34 @@ func ParseGetApiV1DashboardDashboardIdFiltersetsResponse(rsp *http.Response) (*G
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []struct {
-			// A description field of the filter set
-			Description *string `json:"description,omitempty"`
+		var dest struct {
+			// The total record count on the backend
+			Count *float32 `json:"count,omitempty"`
 
-			// metadata of the filter set
-			JsonMetadata *string `json:"json_metadata,omitempty"`
+			// A list of filter set ids
+			Ids    *[]int32 `json:"ids,omitempty"`
+			Result *[]struct {
+				DashboardId *int `json:"dashboard_id,omitempty"`
 
-			// Name of the Filter set
-			Name *string `json:"name,omitempty"`
+				// A description field of the filter set
+				Description *string `json:"description"`
+				Id          *int    `json:"id,omitempty"`
 
-			// A description field of the filter set
-			OwnerId *int `json:"owner_id,omitempty"`
+				// metadata of the filter set
+				JsonMetadata *string `json:"json_metadata,omitempty"`
 
-			// the Type of the owner ( Dashboard/User)
-			OwnerType *int `json:"owner_type,omitempty"`
+				// Name of the Filter set
+				Name *string `json:"name,omitempty"`
 
-			// JSON schema defining the needed parameters
-			Parameters *interface{} `json:"parameters,omitempty"`
+				// The id of the dashboard or user that owns the filter set
+				OwnerId *int `json:"owner_id,omitempty"`
+
+				// the Type of the owner ( Dashboard/User)
+				OwnerType *string `json:"owner_type,omitempty"`
+
+				// JSON schema defining the needed parameters
+				Params *interface{} `json:"params,omitempty"`
+			} `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -32084,7 +32110,7 @@ func ParseGetApiV1ReportPkResponse(rsp *http.Response) (*GetApiV1ReportPkRespons
 			} `json:"description_columns,omitempty"`
 
 			// The item id
-			Id           *string `json:"id,omitempty"`
+			Id           *int32 `json:"id,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -32255,7 +32281,7 @@ func ParseGetApiV1ReportPkLogResponse(rsp *http.Response) (*GetApiV1ReportPkLogR
 			Count *float32 `json:"count,omitempty"`
 
 			// A list of log ids
-			Ids *[]string `json:"ids,omitempty"`
+			Ids *[]int32 `json:"ids,omitempty"`
 
 			// The result from the get list query
 			Result *[]ReportExecutionLogRestApiGetList `json:"result,omitempty"`
@@ -32323,7 +32349,7 @@ func ParseGetApiV1ReportPkLogLogIdResponse(rsp *http.Response) (*GetApiV1ReportP
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			// The log id
-			Id     *string                       `json:"id,omitempty"`
+			Id     *int32                        `json:"id,omitempty"`
 			Result *ReportExecutionLogRestApiGet `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32469,7 +32495,7 @@ func ParseGetApiV1SavedQueryResponse(rsp *http.Response) (*GetApiV1SavedQueryRes
 			} `json:"description_columns,omitempty"`
 
 			// A list of item ids, useful when you don't know the column id
-			Ids          *[]string `json:"ids,omitempty"`
+			Ids          *[]int32 `json:"ids,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -32549,7 +32575,7 @@ func ParsePostApiV1SavedQueryResponse(rsp *http.Response) (*PostApiV1SavedQueryR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
-			Id     *string                `json:"id,omitempty"`
+			Id     *float32               `json:"id,omitempty"`
 			Result *SavedQueryRestApiPost `json:"result,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32995,7 +33021,7 @@ func ParseGetApiV1SavedQueryPkResponse(rsp *http.Response) (*GetApiV1SavedQueryP
 			} `json:"description_columns,omitempty"`
 
 			// The item id
-			Id           *string `json:"id,omitempty"`
+			Id           *int32 `json:"id,omitempty"`
 			LabelColumns *struct {
 				// The label for the column name. Will be translated by babel
 				ColumnName *string `json:"column_name,omitempty"`
@@ -1,11 +1,7 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"io/ioutil"
 	"net/http"
 
@@ -13,13 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func New(baseUrl string, token string, tokenSecret string) (c *ClientWithResponses, err error) {
-	accessToken, err := getPresetAcessToken(token, tokenSecret)
-
-	if err != nil {
-		return nil, err
-	}
-
+func New(baseUrl string, accessToken string) (c *ClientWithResponses, err error) {
 	bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken(accessToken)
 	if err != nil {
 		return nil, err
@@ -70,46 +60,3 @@ func New(baseUrl string, token string, tokenSecret string) (c *ClientWithRespons
 
 	return client, nil
 }
-
-type PresetAuthTokenResponse struct {
-	Payload struct {
-		AccessToken string `json:"access_token"`
-	} `json:"payload"`
-}
-
-func getPresetAcessToken(token string, tokenSecret string) (string, error) {
-	requestBody, err := json.Marshal(map[string]string{
-		"name":   token,
-		"secret": tokenSecret,
-	})
-
-	if err != nil {
-		return "", err
-	}
-
-	resp, err := http.Post("https://manage.app.preset.io/api/v1/auth/", "application/json", bytes.NewBuffer(requestBody))
-
-	if err != nil {
-		return "", err
-	}
-
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-
-	if err != nil {
-		return "", err
-	}
-
-	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("Failed to fetch Preset token: %s", body)
-	}
-
-	var data PresetAuthTokenResponse
-	err = json.Unmarshal(body, &data)
-
-	if err != nil {
-		return "", nil
-	}
-
-	return data.Payload.AccessToken, nil
-}
@@ -0,0 +1,150 @@
+package manage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const DefaultBaseUrl = "https://manage.app.preset.io"
+
+// Client talks to the Preset Manage API, which hosts everything that lives
+// above a single workspace: teams, workspaces, memberships and invites.
+type Client struct {
+	baseUrl     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+func New(accessToken string) *Client {
+	return &Client{
+		baseUrl:     DefaultBaseUrl,
+		accessToken: accessToken,
+		httpClient:  http.DefaultClient,
+	}
+}
+
+type PresetAuthTokenResponse struct {
+	Payload struct {
+		AccessToken string `json:"access_token"`
+	} `json:"payload"`
+}
+
+// GetAccessToken exchanges a Preset API token and secret for a JWT access
+// token. The same token is accepted by the Manage API and by every workspace.
+func GetAccessToken(token string, tokenSecret string) (string, error) {
+	requestBody, err := json.Marshal(map[string]string{
+		"name":   token,
+		"secret": tokenSecret,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Post(DefaultBaseUrl+"/api/v1/auth/", "application/json", bytes.NewBuffer(requestBody))
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("Failed to fetch Preset token: %s", body)
+	}
+
+	var data PresetAuthTokenResponse
+	err = json.Unmarshal(body, &data)
+
+	if err != nil {
+		return "", err
+	}
+
+	return data.Payload.AccessToken, nil
+}
+
+// payloadResponse is the envelope every Manage API response is wrapped in.
+type payloadResponse struct {
+	Payload json.RawMessage `json:"payload"`
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, requestBody interface{}, result interface{}) error {
+	var body []byte
+
+	if requestBody != nil {
+		var err error
+		body, err = json.Marshal(requestBody)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path, bytes.NewReader(body))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+
+	tflog.Debug(ctx, ">> manage request", map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.String(),
+		"body":   string(body),
+	})
+
+	req.Header.Add("Authorization", "Bearer "+c.accessToken)
+
+	resp, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &Error{StatusCode: resp.StatusCode, Body: string(responseBody)}
+	}
+
+	if result == nil || len(responseBody) == 0 {
+		return nil
+	}
+
+	var data payloadResponse
+	err = json.Unmarshal(responseBody, &data)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data.Payload, result)
+}
+
+// Error is returned for any non-2xx Manage API response.
+type Error struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v response returned: %v", e.StatusCode, e.Body)
+}
@@ -0,0 +1,65 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Invite struct {
+	Id                      int64   `json:"id,omitempty"`
+	Email                   string  `json:"email"`
+	TeamRoleId              int64   `json:"team_role_id"`
+	WorkspaceId             *int64  `json:"workspace_id,omitempty"`
+	WorkspaceRoleIdentifier *string `json:"workspace_role_identifier,omitempty"`
+}
+
+func (c *Client) ListInvites(ctx context.Context, teamName string) ([]Invite, error) {
+	var invites []Invite
+	err := c.do(ctx, http.MethodGet, teamPath(teamName)+"/invites", nil, &invites)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// GetInviteByEmail returns nil when there is no pending invite for the email.
+func (c *Client) GetInviteByEmail(ctx context.Context, teamName string, email string) (*Invite, error) {
+	invites, err := c.ListInvites(ctx, teamName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range invites {
+		if strings.EqualFold(v.Email, email) {
+			return &invites[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) CreateInvite(ctx context.Context, teamName string, invite Invite) (*Invite, error) {
+	var invites []Invite
+	err := c.do(ctx, http.MethodPost, teamPath(teamName)+"/invites/many", map[string]interface{}{
+		"invites": []Invite{invite},
+	}, &invites)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invites) != 1 {
+		return nil, fmt.Errorf("Expected one invite to be created for %s, got %d", invite.Email, len(invites))
+	}
+
+	return &invites[0], nil
+}
+
+func (c *Client) DeleteInvite(ctx context.Context, teamName string, inviteId int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/invites/%d", teamPath(teamName), inviteId), nil, nil)
+}
@@ -0,0 +1,138 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const pageSize = 100
+
+type User struct {
+	Id        int64  `json:"id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type TeamRole struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type TeamMembership struct {
+	User     User     `json:"user"`
+	TeamRole TeamRole `json:"team_role"`
+}
+
+type WorkspaceRole struct {
+	Name           string `json:"name"`
+	RoleIdentifier string `json:"role_identifier"`
+}
+
+type WorkspaceMembership struct {
+	User          User          `json:"user"`
+	WorkspaceRole WorkspaceRole `json:"workspace_role"`
+}
+
+// WorkspaceRoles maps the role names shown in the Preset UI to the
+// identifiers the Manage API expects.
+var WorkspaceRoles = map[string]string{
+	"Admin":               "workspace_admin",
+	"Primary Contributor": "primary_contributor",
+	"Limited Contributor": "limited_contributor",
+	"Viewer":              "viewer",
+	"Dashboard Viewer":    "dashboard_viewer",
+	"No Access":           "no_access",
+}
+
+// TeamRoles maps team role names to their Manage API ids.
+var TeamRoles = map[string]int64{
+	"Admin": 1,
+	"User":  2,
+}
+
+func WorkspaceRoleName(roleIdentifier string) string {
+	for name, identifier := range WorkspaceRoles {
+		if identifier == roleIdentifier {
+			return name
+		}
+	}
+
+	return roleIdentifier
+}
+
+// listPaged fetches every page of a paginated Manage API collection.
+func listPaged[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var result []T
+
+	for page := 1; ; page++ {
+		var items []T
+		err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s?page_number=%d&page_size=%d", path, page, pageSize), nil, &items)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, items...)
+
+		if len(items) < pageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *Client) ListTeamMemberships(ctx context.Context, teamName string) ([]TeamMembership, error) {
+	return listPaged[TeamMembership](ctx, c, teamPath(teamName)+"/memberships")
+}
+
+// GetTeamMemberByEmail returns nil when no team member has the given email.
+func (c *Client) GetTeamMemberByEmail(ctx context.Context, teamName string, email string) (*TeamMembership, error) {
+	memberships, err := c.ListTeamMemberships(ctx, teamName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range memberships {
+		if strings.EqualFold(v.User.Email, email) {
+			return &memberships[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) ListWorkspaceMemberships(ctx context.Context, teamName string, workspaceId int64) ([]WorkspaceMembership, error) {
+	return listPaged[WorkspaceMembership](ctx, c, workspacePath(teamName, workspaceId)+"/memberships")
+}
+
+// GetWorkspaceMembership returns nil when the user has no role in the workspace.
+func (c *Client) GetWorkspaceMembership(ctx context.Context, teamName string, workspaceId int64, userId int64) (*WorkspaceMembership, error) {
+	memberships, err := c.ListWorkspaceMemberships(ctx, teamName, workspaceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range memberships {
+		if v.User.Id == userId {
+			return &memberships[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) SetWorkspaceRole(ctx context.Context, teamName string, workspaceId int64, userId int64, roleIdentifier string) error {
+	return c.do(ctx, http.MethodPut, workspacePath(teamName, workspaceId)+"/membership", map[string]interface{}{
+		"role_identifier": roleIdentifier,
+		"user_id":         userId,
+	}, nil)
+}
+
+func (c *Client) DeleteWorkspaceMembership(ctx context.Context, teamName string, workspaceId int64, userId int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/memberships/%d", workspacePath(teamName, workspaceId), userId), nil, nil)
+}
@@ -0,0 +1,57 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Team struct {
+	Id    int64  `json:"id"`
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
+	var teams []Team
+	err := c.do(ctx, http.MethodGet, "/api/v1/teams/", nil, &teams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (c *Client) GetTeamByTitle(ctx context.Context, title string) (*Team, error) {
+	teams, err := c.ListTeams(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Team
+
+	for i, v := range teams {
+		if v.Title != title {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("More than one team found with title %s", title)
+		}
+
+		found = &teams[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Could not find team with title %s", title)
+	}
+
+	return found, nil
+}
+
+func teamPath(teamName string) string {
+	return "/api/v1/teams/" + url.PathEscape(teamName)
+}
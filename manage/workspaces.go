@@ -0,0 +1,64 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Workspace struct {
+	Id       int64  `json:"id"`
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Hostname string `json:"hostname"`
+	Region   string `json:"region"`
+	Status   string `json:"workspace_status"`
+}
+
+// Url is the workspace address used as the provider's base_url.
+func (w Workspace) Url() string {
+	return "https://" + w.Hostname
+}
+
+func (c *Client) ListWorkspaces(ctx context.Context, teamName string) ([]Workspace, error) {
+	var workspaces []Workspace
+	err := c.do(ctx, http.MethodGet, teamPath(teamName)+"/workspaces/", nil, &workspaces)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+func (c *Client) GetWorkspaceByTitle(ctx context.Context, teamName string, title string) (*Workspace, error) {
+	workspaces, err := c.ListWorkspaces(ctx, teamName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Workspace
+
+	for i, v := range workspaces {
+		if v.Title != title {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("More than one workspace found with title %s in team %s", title, teamName)
+		}
+
+		found = &workspaces[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Could not find workspace with title %s in team %s", title, teamName)
+	}
+
+	return found, nil
+}
+
+func workspacePath(teamName string, workspaceId int64) string {
+	return fmt.Sprintf("%s/workspaces/%d", teamPath(teamName), workspaceId)
+}
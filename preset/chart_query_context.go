@@ -0,0 +1,316 @@
+package preset
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// chartQueryBuilders build the query of a chart from its params, mirroring the
+// buildQuery functions of the Superset frontend for the common viz types.
+// Other viz types need a query_context saved from the Explore view.
+var chartQueryBuilders = map[string]func(params map[string]interface{}, query map[string]interface{}){
+	"table":                   buildTableQuery,
+	"big_number_total":        buildBigNumberTotalQuery,
+	"big_number":              buildBigNumberQuery,
+	"pie":                     buildPieQuery,
+	"dist_bar":                buildGroupbyQuery,
+	"echarts_timeseries":      buildTimeseriesQuery,
+	"echarts_timeseries_line": buildTimeseriesQuery,
+	"echarts_timeseries_bar":  buildTimeseriesQuery,
+	"echarts_area":            buildTimeseriesQuery,
+	"pivot_table_v2":          buildPivotTableQuery,
+}
+
+// buildChartQueryContext generates the query_context Superset needs to run a
+// chart without opening it in the Explore view. Returns false when the viz type
+// is not supported.
+func buildChartQueryContext(datasourceId int64, datasourceType string, vizType string, params string) (string, bool, error) {
+	build, ok := chartQueryBuilders[vizType]
+
+	if !ok {
+		return "", false, nil
+	}
+
+	var formData map[string]interface{}
+	err := json.Unmarshal([]byte(params), &formData)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if formData == nil {
+		return "", false, fmt.Errorf("params must be a JSON object")
+	}
+
+	formData["datasource"] = fmt.Sprintf("%d__%s", datasourceId, datasourceType)
+	formData["viz_type"] = vizType
+
+	query := buildBaseQuery(formData)
+	build(formData, query)
+
+	queryContext := map[string]interface{}{
+		"datasource": map[string]interface{}{
+			"id":   datasourceId,
+			"type": datasourceType,
+		},
+		"force":         false,
+		"queries":       []interface{}{query},
+		"form_data":     formData,
+		"result_format": "json",
+		"result_type":   "full",
+	}
+
+	result, err := json.Marshal(queryContext)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(result), true, nil
+}
+
+// buildBaseQuery reads the params shared by all viz types: time range, adhoc
+// filters and row limit.
+func buildBaseQuery(params map[string]interface{}) map[string]interface{} {
+	timeRange, ok := params["time_range"].(string)
+
+	if !ok {
+		timeRange = "No filter"
+	}
+
+	filters := []interface{}{}
+	var where, having []string
+
+	adhocFilters, _ := params["adhoc_filters"].([]interface{})
+
+	for _, f := range adhocFilters {
+		filter, ok := f.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		switch filter["expressionType"] {
+		case "SIMPLE":
+			filters = append(filters, map[string]interface{}{
+				"col": filter["subject"],
+				"op":  filter["operator"],
+				"val": filter["comparator"],
+			})
+		case "SQL":
+			sqlExpression, _ := filter["sqlExpression"].(string)
+
+			if sqlExpression == "" {
+				continue
+			}
+
+			if filter["clause"] == "HAVING" {
+				having = append(having, "("+sqlExpression+")")
+			} else {
+				where = append(where, "("+sqlExpression+")")
+			}
+		}
+	}
+
+	extras := map[string]interface{}{
+		"where":  strings.Join(where, " AND "),
+		"having": strings.Join(having, " AND "),
+	}
+
+	if timeGrain, ok := params["time_grain_sqla"].(string); ok {
+		extras["time_grain_sqla"] = timeGrain
+	}
+
+	query := map[string]interface{}{
+		"time_range":          timeRange,
+		"filters":             filters,
+		"extras":              extras,
+		"applied_time_extras": map[string]interface{}{},
+		"columns":             []interface{}{},
+		"metrics":             []interface{}{},
+		"orderby":             []interface{}{},
+		"annotation_layers":   []interface{}{},
+		"url_params":          map[string]interface{}{},
+		"custom_params":       map[string]interface{}{},
+		"custom_form_data":    map[string]interface{}{},
+	}
+
+	if granularity, ok := params["granularity_sqla"].(string); ok {
+		query["granularity"] = granularity
+	}
+
+	if rowLimit, ok := chartParamInt(params["row_limit"]); ok {
+		query["row_limit"] = rowLimit
+	}
+
+	return query
+}
+
+func buildTableQuery(params map[string]interface{}, query map[string]interface{}) {
+	if params["query_mode"] == "raw" {
+		query["columns"] = chartParamList(params["all_columns"])
+
+		return
+	}
+
+	buildGroupbyQuery(params, query)
+}
+
+func buildBigNumberTotalQuery(params map[string]interface{}, query map[string]interface{}) {
+	query["metrics"] = chartParamList(params["metric"])
+}
+
+// buildBigNumberQuery queries the metric over time for the trendline, pivoted
+// on the x axis.
+func buildBigNumberQuery(params map[string]interface{}, query map[string]interface{}) {
+	metrics := chartParamList(params["metric"])
+	query["metrics"] = metrics
+
+	xAxis, ok := params["x_axis"].(string)
+
+	if !ok {
+		query["is_timeseries"] = true
+		xAxis = "__timestamp"
+	} else {
+		query["columns"] = []interface{}{buildXAxisColumn(params, xAxis)}
+	}
+
+	aggregates := map[string]interface{}{}
+
+	for _, metric := range metrics {
+		aggregates[chartMetricLabel(metric)] = map[string]interface{}{"operator": "mean"}
+	}
+
+	query["post_processing"] = []interface{}{
+		map[string]interface{}{
+			"operation": "pivot",
+			"options": map[string]interface{}{
+				"index":                []interface{}{xAxis},
+				"columns":              []interface{}{},
+				"aggregates":           aggregates,
+				"drop_missing_columns": true,
+			},
+		},
+		map[string]interface{}{
+			"operation": "flatten",
+		},
+	}
+}
+
+func buildPieQuery(params map[string]interface{}, query map[string]interface{}) {
+	metrics := chartParamList(params["metric"])
+
+	query["columns"] = chartParamList(params["groupby"])
+	query["metrics"] = metrics
+
+	if sortByMetric, _ := params["sort_by_metric"].(bool); sortByMetric && len(metrics) > 0 {
+		query["orderby"] = []interface{}{[]interface{}{metrics[0], false}}
+	}
+}
+
+func buildGroupbyQuery(params map[string]interface{}, query map[string]interface{}) {
+	metrics := chartParamList(params["metrics"])
+
+	query["columns"] = chartParamList(params["groupby"])
+	query["metrics"] = metrics
+
+	if len(metrics) > 0 {
+		orderDesc, ok := params["order_desc"].(bool)
+
+		if !ok {
+			orderDesc = true
+		}
+
+		query["orderby"] = []interface{}{[]interface{}{metrics[0], !orderDesc}}
+	}
+}
+
+func buildPivotTableQuery(params map[string]interface{}, query map[string]interface{}) {
+	query["columns"] = append(chartParamList(params["groupbyColumns"]), chartParamList(params["groupbyRows"])...)
+	query["metrics"] = chartParamList(params["metrics"])
+
+	if metric, ok := params["series_limit_metric"]; ok && metric != nil {
+		orderDesc, ok := params["order_desc"].(bool)
+
+		if !ok {
+			orderDesc = true
+		}
+
+		query["orderby"] = []interface{}{[]interface{}{metric, !orderDesc}}
+	}
+}
+
+func buildTimeseriesQuery(params map[string]interface{}, query map[string]interface{}) {
+	buildGroupbyQuery(params, query)
+
+	groupby := chartParamList(params["groupby"])
+	columns := []interface{}{}
+
+	if xAxis, ok := params["x_axis"].(string); ok {
+		columns = append(columns, buildXAxisColumn(params, xAxis))
+	}
+
+	query["columns"] = append(columns, groupby...)
+	query["series_columns"] = groupby
+}
+
+// buildXAxisColumn builds the temporal column of timeseries queries.
+func buildXAxisColumn(params map[string]interface{}, xAxis string) map[string]interface{} {
+	column := map[string]interface{}{
+		"columnType":     "BASE_AXIS",
+		"expressionType": "SQL",
+		"label":          xAxis,
+		"sqlExpression":  xAxis,
+	}
+
+	if timeGrain, ok := params["time_grain_sqla"].(string); ok {
+		column["timeGrain"] = timeGrain
+	}
+
+	return column
+}
+
+// chartMetricLabel returns the label Superset gives the result column of a
+// saved or ad-hoc metric.
+func chartMetricLabel(metric interface{}) string {
+	if adhocMetric, ok := metric.(map[string]interface{}); ok {
+		if label, ok := adhocMetric["label"].(string); ok {
+			return label
+		}
+
+		if sqlExpression, ok := adhocMetric["sqlExpression"].(string); ok {
+			return sqlExpression
+		}
+	}
+
+	return fmt.Sprint(metric)
+}
+
+// chartParamList reads a param that is either a single value or a list.
+func chartParamList(v interface{}) []interface{} {
+	switch value := v.(type) {
+	case nil:
+		return []interface{}{}
+	case []interface{}:
+		return value
+	default:
+		return []interface{}{value}
+	}
+}
+
+// chartParamInt reads a numeric param, which the Explore view sometimes saves
+// as a string.
+func chartParamInt(v interface{}) (int64, bool) {
+	switch value := v.(type) {
+	case float64:
+		return int64(value), true
+	case string:
+		result, err := strconv.ParseInt(value, 10, 64)
+
+		return result, err == nil
+	default:
+		return 0, false
+	}
+}
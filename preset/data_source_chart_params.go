@@ -0,0 +1,425 @@
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ChartParamsMetric struct {
+	Name  types.String `tfsdk:"name"`
+	Sql   types.String `tfsdk:"sql"`
+	Label types.String `tfsdk:"label"`
+}
+
+type ChartParamsFilter struct {
+	Column   types.String `tfsdk:"column"`
+	Operator types.String `tfsdk:"operator"`
+	Values   []string     `tfsdk:"values"`
+}
+
+type ChartParams struct {
+	VizType      types.String        `tfsdk:"viz_type"`
+	Metrics      []ChartParamsMetric `tfsdk:"metrics"`
+	Groupby      []string            `tfsdk:"groupby"`
+	Columns      []string            `tfsdk:"columns"`
+	XAxis        types.String        `tfsdk:"x_axis"`
+	TimeGrain    types.String        `tfsdk:"time_grain"`
+	TimeRange    types.String        `tfsdk:"time_range"`
+	Filters      []ChartParamsFilter `tfsdk:"filters"`
+	Where        types.String        `tfsdk:"where"`
+	RowLimit     types.Int64         `tfsdk:"row_limit"`
+	OrderDesc    types.Bool          `tfsdk:"order_desc"`
+	NumberFormat types.String        `tfsdk:"number_format"`
+	TimeFormat   types.String        `tfsdk:"time_format"`
+	ColorScheme  types.String        `tfsdk:"color_scheme"`
+	ShowLegend   types.Bool          `tfsdk:"show_legend"`
+	Json         types.String        `tfsdk:"json"`
+}
+
+// chartParamsSingleMetric are the viz types that take a single metric.
+var chartParamsSingleMetric = map[string]bool{
+	"big_number_total": true,
+	"big_number":       true,
+	"pie":              true,
+}
+
+type dataSourceChartParamsType struct{}
+
+func (r dataSourceChartParamsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Builds the `params` of a `preset_chart` for common viz types.",
+		Attributes: map[string]tfsdk.Attribute{
+			"viz_type": {
+				Required: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"big_number_total",
+						"big_number",
+						"table",
+						"echarts_timeseries_line",
+						"echarts_timeseries_bar",
+						"pie",
+						"pivot_table_v2",
+					),
+				},
+			},
+			"metrics": {
+				Optional:    true,
+				Description: "Saved metrics by name, or ad-hoc SQL metrics. `big_number_total`, `big_number` and `pie` take a single metric.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						Optional:    true,
+						Type:        types.StringType,
+						Description: "The name of a metric saved on the dataset.",
+					},
+					"sql": {
+						Optional:    true,
+						Type:        types.StringType,
+						Description: "An ad-hoc SQL aggregate, such as `SUM(amount)`.",
+					},
+					"label": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+				}),
+			},
+			"groupby": {
+				Optional:    true,
+				Type:        types.ListType{ElemType: types.StringType},
+				Description: "The columns to group by. These are the rows of a `pivot_table_v2`.",
+			},
+			"columns": {
+				Optional:    true,
+				Type:        types.ListType{ElemType: types.StringType},
+				Description: "The column groups of a `pivot_table_v2`.",
+			},
+			"x_axis": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "The temporal column of timeseries and `big_number` charts.",
+			},
+			"time_grain": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "An ISO 8601 duration such as `P1D`.",
+			},
+			"time_range": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "Defaults to `No filter`.",
+			},
+			"filters": {
+				Optional: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"column": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"operator": {
+						Required: true,
+						Type:     types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf(
+								"==",
+								"!=",
+								">",
+								"<",
+								">=",
+								"<=",
+								"IN",
+								"NOT IN",
+								"LIKE",
+								"ILIKE",
+								"IS NULL",
+								"IS NOT NULL",
+							),
+						},
+					},
+					"values": {
+						Optional:    true,
+						Type:        types.ListType{ElemType: types.StringType},
+						Description: "The values to compare against. Operators other than `IN` and `NOT IN` take a single value.",
+					},
+				}),
+			},
+			"where": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "A custom SQL WHERE clause.",
+			},
+			"row_limit": {
+				Optional: true,
+				Type:     types.Int64Type,
+			},
+			"order_desc": {
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"number_format": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "A D3 format string for metric values, such as `SMART_NUMBER` or `,.2f`.",
+			},
+			"time_format": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "A D3 time format string for temporal values, such as `smart_date` or `%Y-%m-%d`.",
+			},
+			"color_scheme": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"show_legend": {
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"json": {
+				Computed:    true,
+				Type:        jsonStringType{object: true},
+				Description: "The params as normalized JSON, to be used as `preset_chart.params`.",
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceChartParamsType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceChartParams{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceChartParams struct {
+	p presetProvider
+}
+
+func (r dataSourceChartParams) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ChartParams
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := chartParamsFromConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := json.Marshal(params)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error building chart params",
+			"Could not encode chart params, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	config.Json = types.String{Value: string(result)}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// chartParamsFromConfig maps the typed attributes to the form data keys that
+// the Explore view saves for each viz type.
+func chartParamsFromConfig(config ChartParams) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vizType := config.VizType.Value
+	params := map[string]interface{}{
+		"viz_type":   vizType,
+		"time_range": "No filter",
+	}
+
+	if !config.TimeRange.Null {
+		params["time_range"] = config.TimeRange.Value
+	}
+
+	metrics := []interface{}{}
+
+	for i, metric := range config.Metrics {
+		if metric.Name.Null == metric.Sql.Null {
+			diags.AddAttributeError(
+				path.Root("metrics").AtListIndex(i),
+				"Invalid metric",
+				"Exactly one of name or sql must be set",
+			)
+
+			continue
+		}
+
+		if !metric.Name.Null {
+			metrics = append(metrics, metric.Name.Value)
+
+			continue
+		}
+
+		adhocMetric := map[string]interface{}{
+			"expressionType": "SQL",
+			"sqlExpression":  metric.Sql.Value,
+			"label":          metric.Sql.Value,
+			"hasCustomLabel": false,
+		}
+
+		if !metric.Label.Null {
+			adhocMetric["label"] = metric.Label.Value
+			adhocMetric["hasCustomLabel"] = true
+		}
+
+		metrics = append(metrics, adhocMetric)
+	}
+
+	if chartParamsSingleMetric[vizType] {
+		if len(metrics) != 1 {
+			diags.AddAttributeError(
+				path.Root("metrics"),
+				"Invalid metrics",
+				fmt.Sprintf("%s charts take exactly one metric", vizType),
+			)
+		} else {
+			params["metric"] = metrics[0]
+		}
+	} else {
+		params["metrics"] = metrics
+	}
+
+	adhocFilters := []interface{}{}
+
+	for i, filter := range config.Filters {
+		var comparator interface{}
+
+		switch filter.Operator.Value {
+		case "IN", "NOT IN":
+			comparator = filter.Values
+		case "IS NULL", "IS NOT NULL":
+			comparator = nil
+		default:
+			if len(filter.Values) != 1 {
+				diags.AddAttributeError(
+					path.Root("filters").AtListIndex(i).AtName("values"),
+					"Invalid filter values",
+					fmt.Sprintf("The %s operator takes exactly one value", filter.Operator.Value),
+				)
+
+				continue
+			}
+
+			comparator = filter.Values[0]
+		}
+
+		adhocFilters = append(adhocFilters, map[string]interface{}{
+			"expressionType": "SIMPLE",
+			"clause":         "WHERE",
+			"subject":        filter.Column.Value,
+			"operator":       filter.Operator.Value,
+			"comparator":     comparator,
+		})
+	}
+
+	if !config.Where.Null {
+		adhocFilters = append(adhocFilters, map[string]interface{}{
+			"expressionType": "SQL",
+			"clause":         "WHERE",
+			"sqlExpression":  config.Where.Value,
+		})
+	}
+
+	params["adhoc_filters"] = adhocFilters
+
+	groupby := config.Groupby
+
+	if groupby == nil {
+		groupby = []string{}
+	}
+
+	switch vizType {
+	case "pivot_table_v2":
+		columns := config.Columns
+
+		if columns == nil {
+			columns = []string{}
+		}
+
+		params["groupbyRows"] = groupby
+		params["groupbyColumns"] = columns
+	case "big_number_total":
+		// a single aggregate without any grouping
+	default:
+		params["groupby"] = groupby
+	}
+
+	if len(config.Columns) > 0 && vizType != "pivot_table_v2" {
+		diags.AddAttributeError(
+			path.Root("columns"),
+			"Invalid columns",
+			"columns is only supported by pivot_table_v2 charts",
+		)
+	}
+
+	if !config.XAxis.Null {
+		params["x_axis"] = config.XAxis.Value
+	}
+
+	if !config.TimeGrain.Null {
+		params["time_grain_sqla"] = config.TimeGrain.Value
+	}
+
+	if !config.RowLimit.Null {
+		params["row_limit"] = config.RowLimit.Value
+	}
+
+	if !config.OrderDesc.Null {
+		params["order_desc"] = config.OrderDesc.Value
+	}
+
+	if !config.NumberFormat.Null {
+		switch vizType {
+		case "pie":
+			params["number_format"] = config.NumberFormat.Value
+		case "pivot_table_v2":
+			params["valueFormat"] = config.NumberFormat.Value
+		default:
+			params["y_axis_format"] = config.NumberFormat.Value
+		}
+	}
+
+	if !config.TimeFormat.Null {
+		switch vizType {
+		case "table":
+			params["table_timestamp_format"] = config.TimeFormat.Value
+		case "pivot_table_v2":
+			params["date_format"] = config.TimeFormat.Value
+		default:
+			params["x_axis_time_format"] = config.TimeFormat.Value
+		}
+	}
+
+	if !config.ColorScheme.Null {
+		params["color_scheme"] = config.ColorScheme.Value
+	}
+
+	if !config.ShowLegend.Null {
+		params["show_legend"] = config.ShowLegend.Value
+	}
+
+	if vizType == "table" {
+		params["query_mode"] = "aggregate"
+	}
+
+	return params, diags
+}
@@ -0,0 +1,105 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type dataSourceCssTemplateType struct{}
+
+func (r dataSourceCssTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"template_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"css": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceCssTemplateType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceCssTemplate{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceCssTemplate struct {
+	p presetProvider
+}
+
+func (r dataSourceCssTemplate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CssTemplate
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1CssTemplateWithResponse(ctx, &client.GetApiV1CssTemplateParams{
+		Q: &client.GetListSchema{
+			Filters: &[]struct {
+				Col   string      `json:"col"`
+				Opr   string      `json:"opr"`
+				Value interface{} `json:"value"`
+			}{{
+				Col:   "template_name",
+				Opr:   "eq",
+				Value: config.TemplateName.Value,
+			}},
+		},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading css template",
+			fmt.Sprintf("Could not read css template %s, unexpected error: %s",
+				config.TemplateName.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading css template",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	if res.JSON200.Result == nil || len(*res.JSON200.Result) != 1 {
+		resp.Diagnostics.AddError(
+			"Error reading css template",
+			fmt.Sprintf("Zero or more than one css template returned for %s", config.TemplateName.Value),
+		)
+
+		return
+	}
+
+	template := (*res.JSON200.Result)[0]
+	result := cssTemplateFromResponse(int64(*template.Id), template.TemplateName, template.Css)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
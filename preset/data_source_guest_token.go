@@ -0,0 +1,230 @@
+package preset
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type GuestTokenResource struct {
+	Type types.String `tfsdk:"type"`
+	Id   types.String `tfsdk:"id"`
+}
+
+type GuestTokenUser struct {
+	Username  types.String `tfsdk:"username"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
+
+type GuestTokenRlsRule struct {
+	Clause    types.String `tfsdk:"clause"`
+	DatasetId types.Int64  `tfsdk:"dataset_id"`
+}
+
+type GuestToken struct {
+	Resources []GuestTokenResource `tfsdk:"resources"`
+	User      *GuestTokenUser      `tfsdk:"user"`
+	Rls       []GuestTokenRlsRule  `tfsdk:"rls"`
+	Token     types.String         `tfsdk:"token"`
+	ExpiresAt types.String         `tfsdk:"expires_at"`
+}
+
+type dataSourceGuestTokenType struct{}
+
+func (r dataSourceGuestTokenType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Issues a guest token for embedded dashboards. A new token is issued on every read.",
+		Attributes: map[string]tfsdk.Attribute{
+			"resources": {
+				Required: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"type": {
+						Required: true,
+						Type:     types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf(
+								"dashboard",
+							),
+						},
+					},
+					"id": {
+						Required:    true,
+						Type:        types.StringType,
+						Description: "The embedded dashboard uuid, see `preset_dashboard_embedded`.",
+					},
+				}),
+			},
+			"user": {
+				Optional: true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"username": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"first_name": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"last_name": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+				}),
+			},
+			"rls": {
+				Optional:    true,
+				Description: "Row level security clauses applied to the guest's queries.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"clause": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"dataset_id": {
+						Optional:    true,
+						Type:        types.Int64Type,
+						Description: "Limits the clause to a single dataset. Applies to every dataset when unset.",
+					},
+				}),
+			},
+			"token": {
+				Computed:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"expires_at": {
+				Computed:    true,
+				Type:        types.StringType,
+				Description: "When the token expires, in RFC3339 format.",
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceGuestTokenType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceGuestToken{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceGuestToken struct {
+	p presetProvider
+}
+
+func (r dataSourceGuestToken) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GuestToken
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.PostApiV1SecurityGuestTokenJSONRequestBody{
+		Resources: []client.Resource{},
+		Rls:       []client.RlsRule{},
+	}
+
+	for _, resource := range config.Resources {
+		body.Resources = append(body.Resources, client.Resource{
+			Type: resource.Type.Value,
+			Id:   resource.Id.Value,
+		})
+	}
+
+	for _, rule := range config.Rls {
+		rlsRule := client.RlsRule{
+			Clause: rule.Clause.Value,
+		}
+
+		if !rule.DatasetId.Null {
+			datasetId := int32(rule.DatasetId.Value)
+			rlsRule.Dataset = &datasetId
+		}
+
+		body.Rls = append(body.Rls, rlsRule)
+	}
+
+	if config.User != nil {
+		body.User = &client.User1{
+			Username:  stringPointer(config.User.Username),
+			FirstName: stringPointer(config.User.FirstName),
+			LastName:  stringPointer(config.User.LastName),
+		}
+	}
+
+	res, err := r.p.client.PostApiV1SecurityGuestTokenWithResponse(ctx, body)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating guest token",
+			"Could not create guest token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error creating guest token",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	expiresAt, err := guestTokenExpiry(*res.JSON200.Token)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating guest token",
+			"Could not read guest token expiry, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	config.Token = types.String{Value: *res.JSON200.Token}
+	config.ExpiresAt = types.String{Value: expiresAt.UTC().Format(time.RFC3339)}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// guestTokenExpiry reads the exp claim of the guest token JWT.
+func guestTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("Guest token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+
+	err = json.Unmarshal(payload, &claims)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(claims.Exp), 0), nil
+}
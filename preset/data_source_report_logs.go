@@ -0,0 +1,299 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+const defaultReportLogsLimit = 25
+
+type ReportLog struct {
+	Id            types.Int64   `tfsdk:"id"`
+	Uuid          types.String  `tfsdk:"uuid"`
+	State         types.String  `tfsdk:"state"`
+	ErrorMessage  types.String  `tfsdk:"error_message"`
+	Value         types.Float64 `tfsdk:"value"`
+	ScheduledDttm types.String  `tfsdk:"scheduled_dttm"`
+	StartDttm     types.String  `tfsdk:"start_dttm"`
+	EndDttm       types.String  `tfsdk:"end_dttm"`
+}
+
+type ReportLogs struct {
+	ReportId types.Int64  `tfsdk:"report_id"`
+	LogId    types.Int64  `tfsdk:"log_id"`
+	State    types.String `tfsdk:"state"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Logs     []ReportLog  `tfsdk:"logs"`
+}
+
+type dataSourceReportLogsType struct{}
+
+func (r dataSourceReportLogsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Lists the most recent executions of a report schedule, newest first.",
+		Attributes: map[string]tfsdk.Attribute{
+			"report_id": {
+				Required: true,
+				Type:     types.Int64Type,
+			},
+			"log_id": {
+				Optional:    true,
+				Type:        types.Int64Type,
+				Description: "Reads a single execution instead of listing them.",
+			},
+			"state": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "Only returns executions in this state.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"Success",
+						"Working",
+						"Error",
+						"Not triggered",
+						"On Grace",
+					),
+				},
+			},
+			"limit": {
+				Optional:    true,
+				Type:        types.Int64Type,
+				Description: fmt.Sprintf("The maximum number of executions returned. Defaults to %d.", defaultReportLogsLimit),
+			},
+			"logs": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Computed: true,
+						Type:     types.Int64Type,
+					},
+					"uuid": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"state": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"error_message": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"value": {
+						Computed:    true,
+						Type:        types.Float64Type,
+						Description: "The value returned by the alert query.",
+					},
+					"scheduled_dttm": {
+						Computed:    true,
+						Type:        types.StringType,
+						Description: "When the execution was scheduled, in RFC3339 format.",
+					},
+					"start_dttm": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"end_dttm": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceReportLogsType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceReportLogs{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceReportLogs struct {
+	p presetProvider
+}
+
+func (r dataSourceReportLogs) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ReportLogs
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var logs []client.ReportExecutionLogRestApiGetList
+	var err error
+
+	if !config.LogId.Null {
+		logs, err = getReportLog(ctx, r.p.client, int(config.ReportId.Value), int(config.LogId.Value))
+	} else {
+		limit := defaultReportLogsLimit
+
+		if !config.Limit.Null {
+			limit = int(config.Limit.Value)
+		}
+
+		if limit < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid limit",
+				"limit must be at least 1",
+			)
+
+			return
+		}
+
+		logs, err = getReportLogs(ctx, r.p.client, int(config.ReportId.Value), config.State, limit)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading report logs",
+			fmt.Sprintf("Could not read logs of report %d, unexpected error: %s",
+				config.ReportId.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	result := config
+	result.Logs = []ReportLog{}
+
+	for _, log := range logs {
+		if !config.State.Null && log.State != config.State.Value {
+			continue
+		}
+
+		reportLog := ReportLog{
+			Id:            types.Int64{Null: true},
+			Uuid:          types.String{Null: true},
+			State:         types.String{Value: log.State},
+			ErrorMessage:  types.String{Null: true},
+			Value:         types.Float64{Null: true},
+			ScheduledDttm: supersetTimeValue(&log.ScheduledDttm),
+			StartDttm:     supersetTimeValue(log.StartDttm),
+			EndDttm:       supersetTimeValue(log.EndDttm),
+		}
+
+		if log.Id != nil {
+			reportLog.Id = types.Int64{Value: int64(*log.Id)}
+		}
+
+		if log.Uuid != nil {
+			reportLog.Uuid = types.String{Value: log.Uuid.String()}
+		}
+
+		if log.ErrorMessage != nil {
+			reportLog.ErrorMessage = types.String{Value: *log.ErrorMessage}
+		}
+
+		if log.Value != nil {
+			reportLog.Value = types.Float64{Value: float64(*log.Value)}
+		}
+
+		result.Logs = append(result.Logs, reportLog)
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getReportLogs pages through the executions of a report, newest first, until
+// limit executions are found.
+func getReportLogs(ctx context.Context, c *client.ClientWithResponses, reportId int, state types.String, limit int) ([]client.ReportExecutionLogRestApiGetList, error) {
+	pageSize := 100
+
+	if limit < pageSize {
+		pageSize = limit
+	}
+
+	orderColumn := "scheduled_dttm"
+	orderDirection := client.GetListSchemaOrderDirection("desc")
+	q := client.GetListSchema{
+		OrderColumn:    &orderColumn,
+		OrderDirection: &orderDirection,
+		PageSize:       &pageSize,
+	}
+
+	if !state.Null {
+		q.Filters = &[]struct {
+			Col   string      `json:"col"`
+			Opr   string      `json:"opr"`
+			Value interface{} `json:"value"`
+		}{
+			{Col: "state", Opr: "eq", Value: state.Value},
+		}
+	}
+
+	result := []client.ReportExecutionLogRestApiGetList{}
+
+	for page := 0; len(result) < limit; page++ {
+		currentPage := page
+		q.Page = &currentPage
+
+		res, err := c.GetApiV1ReportPkLogWithResponse(ctx, reportId, &client.GetApiV1ReportPkLogParams{
+			Q: &q,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode() != 200 {
+			return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+		}
+
+		if res.JSON200.Result == nil {
+			break
+		}
+
+		result = append(result, *res.JSON200.Result...)
+
+		if len(*res.JSON200.Result) < pageSize {
+			break
+		}
+	}
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+func getReportLog(ctx context.Context, c *client.ClientWithResponses, reportId int, logId int) ([]client.ReportExecutionLogRestApiGetList, error) {
+	res, err := c.GetApiV1ReportPkLogLogIdWithResponse(ctx, reportId, logId, &client.GetApiV1ReportPkLogLogIdParams{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != 200 {
+		return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	return []client.ReportExecutionLogRestApiGetList{
+		client.ReportExecutionLogRestApiGetList(*res.JSON200.Result),
+	}, nil
+}
+
+func supersetTimeValue(t *client.SupersetTime) types.String {
+	if t == nil {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: time.Time(*t).UTC().Format(time.RFC3339)}
+}
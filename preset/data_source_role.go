@@ -0,0 +1,96 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Role struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type dataSourceRoleType struct{}
+
+func (r dataSourceRoleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceRoleType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceRole{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceRole struct {
+	p presetProvider
+}
+
+func (r dataSourceRole) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Role
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	related, err := getDashboardRelated(ctx, r.p.client, "roles", config.Name.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role",
+			fmt.Sprintf("Could not read role %s, unexpected error: %s",
+				config.Name.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	// the related endpoint matches names partially, so only an exact name
+	// match is accepted
+	var ids []int64
+
+	for _, v := range related {
+		if v.Value != nil && v.Text != nil && *v.Text == config.Name.Value {
+			ids = append(ids, int64(*v.Value))
+		}
+	}
+
+	if len(ids) != 1 {
+		resp.Diagnostics.AddError(
+			"Error reading role",
+			fmt.Sprintf("Zero or more than one role is named %s", config.Name.Value),
+		)
+
+		return
+	}
+
+	result := &Role{
+		Id:   types.Int64{Value: ids[0]},
+		Name: config.Name,
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
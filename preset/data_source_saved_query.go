@@ -0,0 +1,128 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type dataSourceSavedQueryType struct{}
+
+func (r dataSourceSavedQueryType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"database_id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"schema": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"label": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"sql": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceSavedQueryType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceSavedQuery{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceSavedQuery struct {
+	p presetProvider
+}
+
+func (r dataSourceSavedQuery) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SavedQuery
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1SavedQueryWithResponse(ctx, &client.GetApiV1SavedQueryParams{
+		Q: &client.GetListSchema{
+			Filters: &[]struct {
+				Col   string      `json:"col"`
+				Opr   string      `json:"opr"`
+				Value interface{} `json:"value"`
+			}{{
+				Col:   "label",
+				Opr:   "eq",
+				Value: config.Label.Value,
+			}},
+		},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			fmt.Sprintf("Could not read saved query %s, unexpected error: %s",
+				config.Label.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	if res.JSON200.Result == nil || len(*res.JSON200.Result) != 1 {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			fmt.Sprintf("Zero or more than one saved query returned for %s", config.Label.Value),
+		)
+
+		return
+	}
+
+	query := (*res.JSON200.Result)[0]
+	result := &SavedQuery{
+		Id:          types.Int64{Value: int64(*query.Id)},
+		DatabaseId:  types.Int64{Null: true},
+		Schema:      optionalStringFromPointer(query.Schema, types.String{Null: true}),
+		Label:       config.Label,
+		Description: optionalStringFromPointer(query.Description, types.String{Null: true}),
+		Sql:         optionalStringFromPointer(query.Sql, types.String{Null: true}),
+	}
+
+	if query.DbId != nil {
+		result.DatabaseId = types.Int64{Value: int64(*query.DbId)}
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,84 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Team struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Title types.String `tfsdk:"title"`
+}
+
+type dataSourceTeamType struct{}
+
+func (r dataSourceTeamType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The team name used to address the team in the Manage API.",
+			},
+			"title": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceTeamType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceTeam{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceTeam struct {
+	p presetProvider
+}
+
+func (r dataSourceTeam) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Team
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.p.manageClient.GetTeamByTitle(ctx, config.Title.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team",
+			fmt.Sprintf("Could not read team %s, unexpected error: %s",
+				config.Title.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	result := &Team{
+		Id:    types.Int64{Value: team.Id},
+		Name:  types.String{Value: team.Name},
+		Title: types.String{Value: team.Title},
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,81 @@
+package preset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Teams struct {
+	Teams []Team `tfsdk:"teams"`
+}
+
+type dataSourceTeamsType struct{}
+
+func (r dataSourceTeamsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"teams": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Computed: true,
+						Type:     types.Int64Type,
+					},
+					"name": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"title": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceTeamsType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceTeams{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceTeams struct {
+	p presetProvider
+}
+
+func (r dataSourceTeams) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	teams, err := r.p.manageClient.ListTeams(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading teams",
+			"Could not read teams, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := &Teams{
+		Teams: []Team{},
+	}
+
+	for _, team := range teams {
+		result.Teams = append(result.Teams, Team{
+			Id:    types.Int64{Value: team.Id},
+			Name:  types.String{Value: team.Name},
+			Title: types.String{Value: team.Title},
+		})
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
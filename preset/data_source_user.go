@@ -0,0 +1,215 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+	"github.com/vercel/terraform-provider-preset/manage"
+)
+
+type User struct {
+	Id       types.Int64  `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+}
+
+type dataSourceUserType struct{}
+
+func (r dataSourceUserType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Looks up a workspace user that can be used in `owner_ids`. Exactly one of `username` or `email` must be set. Looking up by `username` requires `team_name` on the provider.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"username": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"email": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"name": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceUserType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceUser{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceUser struct {
+	p presetProvider
+}
+
+func (r dataSourceUser) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config User
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Username.Null == config.Email.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Invalid user lookup",
+			"Exactly one of username or email must be set",
+		)
+	}
+}
+
+func (r dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config User
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := config.Email.Value
+
+	if !config.Username.Null {
+		// Superset does not return usernames, so they are resolved to an
+		// email through the team members of the Manage API
+		if r.p.teamName == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Error reading user",
+				"Looking up a user by username requires team_name to be set on the provider, use email instead",
+			)
+
+			return
+		}
+
+		email, err := getTeamMemberEmail(ctx, r.p.manageClient, r.p.teamName, config.Username.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading user",
+				fmt.Sprintf("Could not read user %s, unexpected error: %s",
+					config.Username.Value,
+					err,
+				),
+			)
+			return
+		}
+
+		search = email
+	}
+
+	related, err := getDashboardRelated(ctx, r.p.client, "owners", search)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read user %s, unexpected error: %s",
+				search,
+				err,
+			),
+		)
+		return
+	}
+
+	// the related endpoint matches names, usernames and emails partially, so
+	// only an exact email match is accepted
+	var matches []client.RelatedResultResponse
+
+	for _, v := range related {
+		if v.Extra != nil && v.Extra.Email != nil && strings.EqualFold(*v.Extra.Email, search) {
+			matches = append(matches, v)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Zero or more than one user has the email %s", search),
+		)
+
+		return
+	}
+
+	result := &User{
+		Id:       types.Int64{Value: int64(*matches[0].Value)},
+		Username: config.Username,
+		Email:    config.Email,
+		Name:     types.String{Value: *matches[0].Text},
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getTeamMemberEmail returns the email of the team member with the given
+// username.
+func getTeamMemberEmail(ctx context.Context, c *manage.Client, teamName string, username string) (string, error) {
+	memberships, err := c.ListTeamMemberships(ctx, teamName)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range memberships {
+		if strings.EqualFold(v.User.Username, username) {
+			return v.User.Email, nil
+		}
+	}
+
+	return "", fmt.Errorf("no member of team %s has the username %s", teamName, username)
+}
+
+// getDashboardRelated searches the values that can be related to a dashboard
+// through columnName, such as owners or roles.
+func getDashboardRelated(ctx context.Context, c *client.ClientWithResponses, columnName string, filter string) ([]client.RelatedResultResponse, error) {
+	pageSize := 100
+	var result []client.RelatedResultResponse
+
+	for page := 0; ; page++ {
+		currentPage := page
+		res, err := c.GetApiV1DashboardRelatedColumnNameWithResponse(ctx, columnName, &client.GetApiV1DashboardRelatedColumnNameParams{
+			Q: &client.GetRelatedSchema{
+				Filter:   &filter,
+				Page:     &currentPage,
+				PageSize: &pageSize,
+			},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode() != 200 {
+			return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+		}
+
+		if res.JSON200.Result == nil {
+			return result, nil
+		}
+
+		result = append(result, *res.JSON200.Result...)
+
+		if len(*res.JSON200.Result) < pageSize {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Workspace struct {
+	Id       types.Int64  `tfsdk:"id"`
+	TeamName types.String `tfsdk:"team_name"`
+	Title    types.String `tfsdk:"title"`
+	Name     types.String `tfsdk:"name"`
+	Hostname types.String `tfsdk:"hostname"`
+	Url      types.String `tfsdk:"url"`
+	Region   types.String `tfsdk:"region"`
+	Status   types.String `tfsdk:"status"`
+}
+
+type dataSourceWorkspaceType struct{}
+
+func (r dataSourceWorkspaceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"team_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"title": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"name": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"url": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The workspace URL, suitable for the provider `base_url`.",
+			},
+			"region": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceWorkspaceType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceWorkspace{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceWorkspace struct {
+	p presetProvider
+}
+
+func (r dataSourceWorkspace) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Workspace
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := r.p.manageClient.GetWorkspaceByTitle(ctx, config.TeamName.Value, config.Title.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace",
+			fmt.Sprintf("Could not read workspace %s, unexpected error: %s",
+				config.Title.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	result := &Workspace{
+		Id:       types.Int64{Value: workspace.Id},
+		TeamName: config.TeamName,
+		Title:    types.String{Value: workspace.Title},
+		Name:     types.String{Value: workspace.Name},
+		Hostname: types.String{Value: workspace.Hostname},
+		Url:      types.String{Value: workspace.Url()},
+		Region:   types.String{Value: workspace.Region},
+		Status:   types.String{Value: workspace.Status},
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,129 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/manage"
+)
+
+type WorkspaceMember struct {
+	UserId    types.Int64  `tfsdk:"user_id"`
+	Username  types.String `tfsdk:"username"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Role      types.String `tfsdk:"role"`
+}
+
+type WorkspaceMembers struct {
+	TeamName    types.String      `tfsdk:"team_name"`
+	WorkspaceId types.Int64       `tfsdk:"workspace_id"`
+	Members     []WorkspaceMember `tfsdk:"members"`
+}
+
+type dataSourceWorkspaceMembersType struct{}
+
+func (r dataSourceWorkspaceMembersType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"team_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"workspace_id": {
+				Required: true,
+				Type:     types.Int64Type,
+			},
+			"members": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"user_id": {
+						Computed: true,
+						Type:     types.Int64Type,
+					},
+					"username": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"email": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"first_name": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"last_name": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"role": {
+						Computed: true,
+						Type:     types.StringType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceWorkspaceMembersType) NewDataSource(ctx context.Context, p provider.Provider) (datasource.DataSource, diag.Diagnostics) {
+	return dataSourceWorkspaceMembers{
+		p: *(p.(*presetProvider)),
+	}, nil
+}
+
+type dataSourceWorkspaceMembers struct {
+	p presetProvider
+}
+
+func (r dataSourceWorkspaceMembers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config WorkspaceMembers
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.p.manageClient.ListWorkspaceMemberships(ctx, config.TeamName.Value, config.WorkspaceId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace members",
+			fmt.Sprintf("Could not read members of workspace %d, unexpected error: %s",
+				config.WorkspaceId.Value,
+				err,
+			),
+		)
+		return
+	}
+
+	result := &WorkspaceMembers{
+		TeamName:    config.TeamName,
+		WorkspaceId: config.WorkspaceId,
+		Members:     []WorkspaceMember{},
+	}
+
+	for _, membership := range memberships {
+		result.Members = append(result.Members, WorkspaceMember{
+			UserId:    types.Int64{Value: membership.User.Id},
+			Username:  types.String{Value: membership.User.Username},
+			Email:     types.String{Value: membership.User.Email},
+			FirstName: types.String{Value: membership.User.FirstName},
+			LastName:  types.String{Value: membership.User.LastName},
+			Role:      types.String{Value: manage.WorkspaceRoleName(membership.WorkspaceRole.RoleIdentifier)},
+		})
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,180 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+// relatedObject is a chart or dashboard that still uses an object being
+// deleted.
+type relatedObject struct {
+	Id   int64
+	Name string
+}
+
+// relatedObjects are looked up right before deletion. Charts managed by
+// Terraform are not always gone by then: when an object is replaced, the old
+// one is destroyed before its dependents are updated to the new one. These
+// charts are marked as managed externally and are only warned about, see
+// splitManagedCharts.
+type relatedObjects struct {
+	Charts     []relatedObject
+	Dashboards []relatedObject
+}
+
+func newRelatedObject(id int32, name *string) relatedObject {
+	result := relatedObject{Id: int64(id)}
+
+	if name != nil {
+		result.Name = *name
+	}
+
+	return result
+}
+
+func (o relatedObjects) empty() bool {
+	return len(o.Charts) == 0 && len(o.Dashboards) == 0
+}
+
+func (o relatedObjects) String() string {
+	var parts []string
+
+	if len(o.Charts) > 0 {
+		parts = append(parts, "charts "+describeRelatedObjects(o.Charts))
+	}
+
+	if len(o.Dashboards) > 0 {
+		parts = append(parts, "dashboards "+describeRelatedObjects(o.Dashboards))
+	}
+
+	return strings.Join(parts, " and ")
+}
+
+func describeRelatedObjects(objects []relatedObject) string {
+	names := make([]string, len(objects))
+
+	for i, o := range objects {
+		names[i] = fmt.Sprintf("%q (%d)", o.Name, o.Id)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// applyDeletionPolicy decides whether kind can be deleted while related
+// objects still use it. block, the default, refuses. warn lets the deletion
+// go ahead and cascade also deletes the related charts first. Dashboards are
+// never deleted: they only lose the charts.
+func applyDeletionPolicy(ctx context.Context, c *client.ClientWithResponses, policy types.String, kind string, related relatedObjects) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	related, managed, err := splitManagedCharts(ctx, c, related)
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error deleting %s", kind),
+			"Could not read which charts are managed externally, unexpected error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	if len(managed) > 0 {
+		diags.AddWarning(
+			fmt.Sprintf("Deleting %s used by managed charts", kind),
+			fmt.Sprintf("The %s is still used by charts %s, which are managed externally and were not counted by deletion_policy. "+
+				"Charts managed by Terraform are updated later in this apply, charts imported with the Preset CLI or Git sync will break.",
+				kind, describeRelatedObjects(managed)),
+		)
+	}
+
+	if related.empty() {
+		return diags
+	}
+
+	detail := fmt.Sprintf("The %s is still used by %s, which are not managed externally.", kind, related)
+
+	switch policy.Value {
+	case "warn":
+		diags.AddWarning(
+			fmt.Sprintf("Deleting %s in use", kind),
+			detail+" They will break.",
+		)
+	case "cascade":
+		for _, chart := range related.Charts {
+			res, err := c.DeleteApiV1ChartPkWithResponse(ctx, int(chart.Id))
+
+			if err != nil {
+				diags.AddError(
+					"Error deleting chart",
+					"Could not delete chart, unexpected error: "+err.Error(),
+				)
+
+				return diags
+			}
+
+			if res.StatusCode() != 200 && res.StatusCode() != 404 {
+				diags.AddError(
+					"Error deleting chart",
+					fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+				)
+
+				return diags
+			}
+		}
+
+		diags.AddWarning(
+			fmt.Sprintf("Deleting %s in use", kind),
+			detail+" The charts were deleted.",
+		)
+	default:
+		diags.AddError(
+			fmt.Sprintf("Error deleting %s", kind),
+			detail+" Set deletion_policy to warn or cascade to delete it anyway.",
+		)
+	}
+
+	return diags
+}
+
+// splitManagedCharts separates the related charts that are managed
+// externally, as the charts created by this provider are, from the others.
+// Dashboards only break through their charts, so they are left out too when
+// no other chart remains.
+func splitManagedCharts(ctx context.Context, c *client.ClientWithResponses, related relatedObjects) (relatedObjects, []relatedObject, error) {
+	var result relatedObjects
+	var managed []relatedObject
+
+	for _, chart := range related.Charts {
+		res, err := c.GetApiV1ChartPkWithResponse(ctx, int(chart.Id), &client.GetApiV1ChartPkParams{})
+
+		if err != nil {
+			return related, nil, err
+		}
+
+		// deleted in the meantime
+		if res.StatusCode() == 404 {
+			continue
+		}
+
+		if res.StatusCode() != 200 {
+			return related, nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+		}
+
+		if res.JSON200.Result != nil && res.JSON200.Result.IsManagedExternally != nil && *res.JSON200.Result.IsManagedExternally {
+			managed = append(managed, chart)
+		} else {
+			result.Charts = append(result.Charts, chart)
+		}
+	}
+
+	if len(result.Charts) > 0 {
+		result.Dashboards = related.Dashboards
+	}
+
+	return result, managed, nil
+}
@@ -10,10 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-preset/client"
+	"github.com/vercel/terraform-provider-preset/manage"
 )
 
 type presetProvider struct {
-	client *client.ClientWithResponses
+	client       *client.ClientWithResponses
+	manageClient *manage.Client
+	teamName     string
+
+	lookupVizTypes     bool
+	validateDatasetSql bool
 }
 
 func New() provider.Provider {
@@ -40,29 +46,74 @@ func (p *presetProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				Optional:    true,
 				Description: "The Preset workspace URL.  This can also be specified with the `PRESET_BASE_URL` shell environment variable.",
 			},
+			"team_name": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the Preset team that owns the workspace. Used together with `workspace_title` to look up `base_url` through the Manage API, and by the `preset_user` data source to look up users by username.",
+			},
+			"workspace_title": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The title of the Preset workspace. Used together with `team_name` to look up `base_url` through the Manage API.",
+			},
+			"lookup_viz_types": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept chart viz types that are not built into Superset when other charts of the workspace already use them, for plugins only installed in that workspace. Defaults to `false`.",
+			},
+			"validate_dataset_sql": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Validate the SQL of datasets during plan, for database engines that support it. This calls the workspace API while planning. Defaults to `false`.",
+			},
 		},
 	}, nil
 }
 
 func (p *presetProvider) GetResources(_ context.Context) (map[string]provider.ResourceType, diag.Diagnostics) {
 	return map[string]provider.ResourceType{
-		"preset_dashboard":        resourceDashboardType{},
-		"preset_dashboard_filter": resourceDashboardFilterType{},
-		"preset_dataset":          resourceDatasetType{},
-		"preset_chart":            resourceChartType{},
+		"preset_dashboard":            resourceDashboardType{},
+		"preset_dashboard_filter":     resourceDashboardFilterType{},
+		"preset_dashboard_layout":     resourceDashboardLayoutType{},
+		"preset_dashboard_filter_set": resourceDashboardFilterSetType{},
+		"preset_dashboard_embedded":   resourceDashboardEmbeddedType{},
+		"preset_dataset":              resourceDatasetType{},
+		"preset_chart":                resourceChartType{},
+		"preset_workspace_membership": resourceWorkspaceMembershipType{},
+		"preset_team_invite":          resourceTeamInviteType{},
+		"preset_report_schedule":      resourceReportScheduleType{},
+		"preset_css_template":         resourceCssTemplateType{},
+		"preset_annotation_layer":     resourceAnnotationLayerType{},
+		"preset_annotation":           resourceAnnotationType{},
+		"preset_saved_query":          resourceSavedQueryType{},
 	}, nil
 }
 
 func (p *presetProvider) GetDataSources(_ context.Context) (map[string]provider.DataSourceType, diag.Diagnostics) {
 	return map[string]provider.DataSourceType{
-		"preset_database": dataSourceDatabaseType{},
+		"preset_database":          dataSourceDatabaseType{},
+		"preset_teams":             dataSourceTeamsType{},
+		"preset_team":              dataSourceTeamType{},
+		"preset_workspace":         dataSourceWorkspaceType{},
+		"preset_workspace_members": dataSourceWorkspaceMembersType{},
+		"preset_user":              dataSourceUserType{},
+		"preset_role":              dataSourceRoleType{},
+		"preset_guest_token":       dataSourceGuestTokenType{},
+		"preset_report_logs":       dataSourceReportLogsType{},
+		"preset_css_template":      dataSourceCssTemplateType{},
+		"preset_saved_query":       dataSourceSavedQueryType{},
+		"preset_chart_params":      dataSourceChartParamsType{},
 	}, nil
 }
 
 type providerData struct {
-	ApiToken  types.String `tfsdk:"api_token"`
-	ApiSecret types.String `tfsdk:"api_secret"`
-	BaseURL   types.String `tfsdk:"base_url"`
+	ApiToken           types.String `tfsdk:"api_token"`
+	ApiSecret          types.String `tfsdk:"api_secret"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	TeamName           types.String `tfsdk:"team_name"`
+	WorkspaceTitle     types.String `tfsdk:"workspace_title"`
+	LookupVizTypes     types.Bool   `tfsdk:"lookup_viz_types"`
+	ValidateDatasetSql types.Bool   `tfsdk:"validate_dataset_sql"`
 }
 
 func (p *presetProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -101,6 +152,18 @@ func (p *presetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	accessToken, err := manage.GetAccessToken(apiToken, apiTokenSecret)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating client",
+			"Could not authenticate with Preset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	manageClient := manage.New(accessToken)
+
 	var baseUrl string
 
 	if config.BaseURL.Null {
@@ -109,10 +172,24 @@ func (p *presetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		baseUrl = config.BaseURL.Value
 	}
 
+	if baseUrl == "" && !config.TeamName.Null && !config.WorkspaceTitle.Null {
+		workspace, err := manageClient.GetWorkspaceByTitle(ctx, config.TeamName.Value, config.WorkspaceTitle.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find base_url",
+				"Could not look up workspace, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		baseUrl = workspace.Url()
+	}
+
 	if baseUrl == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find base_url",
-			"base_url cannot be an empty string",
+			"base_url cannot be an empty string, set it directly or set both team_name and workspace_title",
 		)
 		return
 	}
@@ -121,7 +198,7 @@ func (p *presetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		"baseUrl": baseUrl,
 	})
 
-	client, err := client.New(baseUrl, apiToken, apiTokenSecret)
+	client, err := client.New(baseUrl, accessToken)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -132,4 +209,8 @@ func (p *presetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	p.client = client
+	p.manageClient = manageClient
+	p.teamName = config.TeamName.Value
+	p.lookupVizTypes = !config.LookupVizTypes.Null && config.LookupVizTypes.Value
+	p.validateDatasetSql = !config.ValidateDatasetSql.Null && config.ValidateDatasetSql.Value
 }
@@ -0,0 +1,367 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type Annotation struct {
+	Id           types.Int64  `tfsdk:"id"`
+	LayerId      types.Int64  `tfsdk:"layer_id"`
+	ShortDescr   types.String `tfsdk:"short_descr"`
+	LongDescr    types.String `tfsdk:"long_descr"`
+	StartDttm    types.String `tfsdk:"start_dttm"`
+	EndDttm      types.String `tfsdk:"end_dttm"`
+	JsonMetadata types.String `tfsdk:"json_metadata"`
+}
+
+type resourceAnnotationType struct{}
+
+func (r resourceAnnotationType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"layer_id": {
+				Required:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"short_descr": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"long_descr": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"start_dttm": {
+				Required:    true,
+				Type:        types.StringType,
+				Description: "When the annotation starts, in RFC3339 format.",
+				Validators: []tfsdk.AttributeValidator{
+					rfc3339(),
+				},
+			},
+			"end_dttm": {
+				Required:    true,
+				Type:        types.StringType,
+				Description: "When the annotation ends, in RFC3339 format.",
+				Validators: []tfsdk.AttributeValidator{
+					rfc3339(),
+				},
+			},
+			"json_metadata": {
+				Optional:      true,
+				Type:          jsonStringType{},
+				PlanModifiers: tfsdk.AttributePlanModifiers{jsonSemanticEquality()},
+			},
+		},
+	}, nil
+}
+
+func (r resourceAnnotationType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceAnnotation{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceAnnotation struct {
+	p presetProvider
+}
+
+func (r resourceAnnotation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var annotation Annotation
+	diags := req.Plan.Get(ctx, &annotation)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startDttm, endDttm, err := annotationTimes(annotation)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating annotation",
+			"Could not parse annotation times: "+err.Error(),
+		)
+
+		return
+	}
+
+	res, err := r.p.client.PostApiV1AnnotationLayerPkAnnotationWithResponse(ctx, int(annotation.LayerId.Value), client.PostApiV1AnnotationLayerPkAnnotationJSONRequestBody{
+		ShortDescr:   annotation.ShortDescr.Value,
+		LongDescr:    stringPointer(annotation.LongDescr),
+		StartDttm:    startDttm,
+		EndDttm:      endDttm,
+		JsonMetadata: stringPointer(annotation.JsonMetadata),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating annotation",
+			"Could not create annotation, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating annotation",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	annotation.Id = types.Int64{Value: int64(*res.JSON201.Id)}
+
+	diags = resp.State.Set(ctx, annotation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Annotation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1AnnotationLayerPkAnnotationAnnotationIdWithResponse(ctx, int(state.LayerId.Value), int(state.Id.Value), &client.GetApiV1AnnotationLayerPkAnnotationAnnotationIdParams{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading annotation",
+			"Could not read annotation, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading annotation",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	annotation := res.JSON200.Result
+	result := &Annotation{
+		Id:           state.Id,
+		LayerId:      state.LayerId,
+		ShortDescr:   types.String{Value: ""},
+		LongDescr:    optionalStringFromPointer(annotation.LongDescr, state.LongDescr),
+		StartDttm:    annotationTimeValue(annotation.StartDttm, state.StartDttm),
+		EndDttm:      annotationTimeValue(annotation.EndDttm, state.EndDttm),
+		JsonMetadata: jsonStringFromPointer(annotation.JsonMetadata, state.JsonMetadata),
+	}
+
+	if annotation.ShortDescr != nil {
+		result.ShortDescr = types.String{Value: *annotation.ShortDescr}
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var annotation Annotation
+	diags := req.Plan.Get(ctx, &annotation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Annotation
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startDttm, endDttm, err := annotationTimes(annotation)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating annotation",
+			"Could not parse annotation times: "+err.Error(),
+		)
+
+		return
+	}
+
+	// an empty object clears json_metadata removed from the configuration
+	jsonMetadata := "{}"
+
+	if !annotation.JsonMetadata.Null {
+		jsonMetadata = annotation.JsonMetadata.Value
+	}
+
+	res, err := r.p.client.PutApiV1AnnotationLayerPkAnnotationAnnotationIdWithResponse(ctx, int(state.LayerId.Value), int(state.Id.Value), client.PutApiV1AnnotationLayerPkAnnotationAnnotationIdJSONRequestBody{
+		ShortDescr:   &annotation.ShortDescr.Value,
+		LongDescr:    emptyStringPointer(annotation.LongDescr),
+		StartDttm:    &startDttm,
+		EndDttm:      &endDttm,
+		JsonMetadata: &jsonMetadata,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating annotation",
+			"Could not update annotation, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating annotation",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	annotation.Id = state.Id
+
+	diags = resp.State.Set(ctx, annotation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Annotation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1AnnotationLayerPkAnnotationAnnotationIdWithResponse(ctx, int(state.LayerId.Value), int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting annotation",
+			"Could not delete annotation, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting annotation",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts "layer_id/annotation_id".
+func (r resourceAnnotation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Error importing annotation",
+			fmt.Sprintf("Expected an import id of the form layer_id/annotation_id, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	layerId, err := strconv.ParseInt(parts[0], 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing annotation",
+			"Could not parse annotation layer id: "+err.Error(),
+		)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing annotation",
+			"Could not parse annotation id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("layer_id"), layerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// annotationTimes converts the configured times to UTC, because Superset
+// stores them without a timezone.
+func annotationTimes(annotation Annotation) (time.Time, time.Time, error) {
+	startDttm, err := time.Parse(time.RFC3339, annotation.StartDttm.Value)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	endDttm, err := time.Parse(time.RFC3339, annotation.EndDttm.Value)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return startDttm.UTC(), endDttm.UTC(), nil
+}
+
+// annotationTimeValue keeps the configured representation of a time when it
+// is the same instant as the one returned, so that timezone offsets in the
+// configuration don't show up as a diff.
+func annotationTimeValue(t *client.SupersetTime, prior types.String) types.String {
+	if t == nil {
+		return types.String{Null: true}
+	}
+
+	value := time.Time(*t)
+
+	if priorValue, err := time.Parse(time.RFC3339, prior.Value); err == nil && priorValue.Equal(value) {
+		return prior
+	}
+
+	return types.String{Value: value.UTC().Format(time.RFC3339)}
+}
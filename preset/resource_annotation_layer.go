@@ -0,0 +1,247 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type AnnotationLayer struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Descr types.String `tfsdk:"descr"`
+}
+
+type resourceAnnotationLayerType struct{}
+
+func (r resourceAnnotationLayerType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"descr": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r resourceAnnotationLayerType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceAnnotationLayer{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceAnnotationLayer struct {
+	p presetProvider
+}
+
+func (r resourceAnnotationLayer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var layer AnnotationLayer
+	diags := req.Plan.Get(ctx, &layer)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PostApiV1AnnotationLayerWithResponse(ctx, client.PostApiV1AnnotationLayerJSONRequestBody{
+		Name:  layer.Name.Value,
+		Descr: stringPointer(layer.Descr),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating annotation layer",
+			"Could not create annotation layer, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating annotation layer",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := &AnnotationLayer{
+		Id:    types.Int64{Value: int64(*res.JSON201.Id)},
+		Name:  layer.Name,
+		Descr: layer.Descr,
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotationLayer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AnnotationLayer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1AnnotationLayerPkWithResponse(ctx, int(state.Id.Value), &client.GetApiV1AnnotationLayerPkParams{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading annotation layer",
+			"Could not read annotation layer, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading annotation layer",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := &AnnotationLayer{
+		Id:    state.Id,
+		Name:  types.String{Value: *res.JSON200.Result.Name},
+		Descr: optionalStringFromPointer(res.JSON200.Result.Descr, state.Descr),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotationLayer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var layer AnnotationLayer
+	diags := req.Plan.Get(ctx, &layer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AnnotationLayer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	descr := ""
+
+	if !layer.Descr.Null {
+		descr = layer.Descr.Value
+	}
+
+	res, err := r.p.client.PutApiV1AnnotationLayerPkWithResponse(ctx, int(state.Id.Value), client.PutApiV1AnnotationLayerPkJSONRequestBody{
+		Name:  &layer.Name.Value,
+		Descr: &descr,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating annotation layer",
+			"Could not update annotation layer, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating annotation layer",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := &AnnotationLayer{
+		Id:    state.Id,
+		Name:  layer.Name,
+		Descr: layer.Descr,
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceAnnotationLayer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AnnotationLayer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1AnnotationLayerPkWithResponse(ctx, int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting annotation layer",
+			"Could not delete annotation layer, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting annotation layer",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts the annotation layer id.
+func (r resourceAnnotationLayer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing annotation layer",
+			"Could not parse annotation layer id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -2,9 +2,13 @@ package preset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -13,18 +17,47 @@ import (
 )
 
 type Chart struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Title          types.String `tfsdk:"title"`
+	DashboardIds   types.Set    `tfsdk:"dashboard_ids"`
+	DatasetId      types.Int64  `tfsdk:"dataset_id"`
+	DatasourceType types.String `tfsdk:"datasource_type"`
+	VizType        types.String `tfsdk:"viz_type"`
+	Params         types.String `tfsdk:"params"`
+	OwnerIds       types.Set    `tfsdk:"owner_ids"`
+	IgnoredParams  types.Set    `tfsdk:"ignored_params"`
+
+	QueryContext           types.String `tfsdk:"query_context"`
+	QueryContextGeneration types.Bool   `tfsdk:"query_context_generation"`
+
+	Description          types.String `tfsdk:"description"`
+	CacheTimeout         types.Int64  `tfsdk:"cache_timeout"`
+	CertifiedBy          types.String `tfsdk:"certified_by"`
+	CertificationDetails types.String `tfsdk:"certification_details"`
+	ExternalUrl          types.String `tfsdk:"external_url"`
+}
+
+// defaultChartIgnoredParams are the params keys that Superset adds when a chart
+// is saved in the Explore view.
+var defaultChartIgnoredParams = []string{"datasource", "slice_id"}
+
+// ChartV0 is the chart state before charts could be attached to several
+// dashboards.
+type ChartV0 struct {
 	Id          types.Int64  `tfsdk:"id"`
 	Title       types.String `tfsdk:"title"`
 	DashboardId types.Int64  `tfsdk:"dashboard_id"`
 	DatasetId   types.Int64  `tfsdk:"dataset_id"`
 	VizType     types.String `tfsdk:"viz_type"`
 	Params      types.String `tfsdk:"params"`
+	OwnerIds    types.Set    `tfsdk:"owner_ids"`
 }
 
 type resourceChartType struct{}
 
 func (r resourceChartType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
+		Version: 1,
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
 				Computed:      true,
@@ -35,20 +68,83 @@ func (r resourceChartType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Required: true,
 				Type:     types.StringType,
 			},
-			"dashboard_id": {
-				Required: true,
-				Type:     types.Int64Type,
+			"dashboard_ids": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.Int64Type},
+				Description: "Ids of the dashboards the chart is attached to. When unset, dashboard associations are not managed.",
 			},
 			"dataset_id": {
-				Required: true,
-				Type:     types.Int64Type,
+				Required:    true,
+				Type:        types.Int64Type,
+				Description: "The id of the datasource of the chart, a dataset unless `datasource_type` says otherwise.",
+			},
+			"datasource_type": {
+				Optional:    true,
+				Computed:    true,
+				Type:        types.StringType,
+				Description: "The type of the datasource, such as `query` for charts built on SQL Lab queries. Defaults to `table`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"table",
+						"dataset",
+						"query",
+						"saved_query",
+						"sl_table",
+						"view",
+					),
+				},
 			},
 			"viz_type": {
 				Required: true,
 				Type:     types.StringType,
 			},
 			"params": {
-				Required: true,
+				Required:      true,
+				Type:          jsonStringType{object: true},
+				PlanModifiers: tfsdk.AttributePlanModifiers{jsonSemanticEquality()},
+			},
+			"owner_ids": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.Int64Type},
+				Description: "Ids of the users that own the chart. See the `preset_user` data source.",
+			},
+			"ignored_params": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.StringType},
+				Description: "Top level `params` keys that are ignored when detecting changes made outside of Terraform. Defaults to `datasource` and `slice_id`, which Superset sets when a chart is saved.",
+			},
+			"query_context": {
+				Optional:      true,
+				Computed:      true,
+				Type:          jsonStringType{},
+				Description:   "The queries Superset runs for reports, alerts and the chart data API. When unset, it is generated from `params` for the `table`, `big_number_total`, `big_number`, `pie`, `dist_bar`, `pivot_table_v2` and ECharts timeseries viz types.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{jsonSemanticEquality()},
+			},
+			"query_context_generation": {
+				Optional:    true,
+				Type:        types.BoolType,
+				Description: "Whether the Explore view may regenerate `query_context` when the chart is saved.",
+			},
+			"description": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"cache_timeout": {
+				Optional:    true,
+				Type:        types.Int64Type,
+				Description: "Seconds before the chart data cache expires. Defaults to the timeout of the dataset.",
+			},
+			"certified_by": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "The person or team that certified the chart. Certified charts are flagged as trusted in the UI.",
+			},
+			"certification_details": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"external_url": {
+				Optional: true,
 				Type:     types.StringType,
 			},
 		},
@@ -65,6 +161,120 @@ type resourceChart struct {
 	p presetProvider
 }
 
+// ModifyPlan validates viz_type and generates query_context from params when
+// it is not configured.
+// A query_context saved from the Explore view is kept until params, the
+// dataset or the viz type change.
+func (r resourceChart) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Chart
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configDatasourceType types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("datasource_type"), &configDatasourceType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configDatasourceType.Null {
+		plan.DatasourceType = types.String{Value: "table"}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("datasource_type"), plan.DatasourceType)...)
+	}
+
+	// only new viz types are validated, so that charts using a plugin that
+	// is not known to the provider keep working
+	var stateVizType types.String
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.GetAttribute(ctx, path.Root("viz_type"), &stateVizType)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.VizType.Unknown && !plan.VizType.Equal(stateVizType) {
+		resp.Diagnostics.Append(validateVizType(ctx, r.p.client, r.p.lookupVizTypes, plan.VizType.Value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var config types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("query_context"), &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Null {
+		return
+	}
+
+	if plan.DatasetId.Unknown || plan.DatasourceType.Unknown || plan.VizType.Unknown || plan.Params.Unknown {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state Chart
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.QueryContext.Null &&
+			state.Params.Equal(plan.Params) &&
+			state.DatasetId.Equal(plan.DatasetId) &&
+			state.DatasourceType.Equal(plan.DatasourceType) &&
+			state.VizType.Equal(plan.VizType) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query_context"), state.QueryContext)...)
+
+			return
+		}
+	}
+
+	queryContext, diags := generateChartQueryContext(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query_context"), queryContext)...)
+}
+
+// generateChartQueryContext builds the query_context of chart from its params.
+// The result is null when the viz type has no query builder.
+func generateChartQueryContext(chart Chart) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	generated, ok, err := buildChartQueryContext(chart.DatasetId.Value, chart.DatasourceType.Value, chart.VizType.Value, chart.Params.Value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("params"),
+			"Error generating chart query context",
+			"Could not generate query_context from params, unexpected error: "+err.Error(),
+		)
+
+		return types.String{Null: true}, diags
+	}
+
+	if !ok {
+		return types.String{Null: true}, diags
+	}
+
+	return types.String{Value: generated}, diags
+}
+
 func (r resourceChart) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var chart Chart
 	diags := req.Plan.Get(ctx, &chart)
@@ -74,16 +284,45 @@ func (r resourceChart) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	dashboardId := int32(chart.DashboardId.Value)
+	owners, diags := int32SlicePointer(ctx, chart.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboards, diags := int32SlicePointer(ctx, chart.DashboardIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the query context could not be generated at plan time when the dataset
+	// or params were not known yet
+	if chart.QueryContext.Unknown {
+		chart.QueryContext, diags = generateChartQueryContext(chart)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	isManagedExternally := true
 	res, err := r.p.client.PostApiV1ChartWithResponse(ctx, client.PostApiV1ChartJSONRequestBody{
-		SliceName:           chart.Title.Value,
-		DatasourceId:        int32(chart.DatasetId.Value),
-		DatasourceType:      "table",
-		Dashboards:          &[]int32{dashboardId},
-		VizType:             &chart.VizType.Value,
-		Params:              &chart.Params.Value,
-		IsManagedExternally: &isManagedExternally,
+		SliceName:              chart.Title.Value,
+		DatasourceId:           int32(chart.DatasetId.Value),
+		DatasourceType:         client.ChartRestApiPostDatasourceType(chart.DatasourceType.Value),
+		Dashboards:             dashboards,
+		VizType:                &chart.VizType.Value,
+		Params:                 &chart.Params.Value,
+		QueryContext:           stringPointer(chart.QueryContext),
+		QueryContextGeneration: boolPointer(chart.QueryContextGeneration),
+		Description:            stringPointer(chart.Description),
+		CacheTimeout:           int32Pointer(chart.CacheTimeout),
+		CertifiedBy:            stringPointer(chart.CertifiedBy),
+		CertificationDetails:   stringPointer(chart.CertificationDetails),
+		ExternalUrl:            stringPointer(chart.ExternalUrl),
+		IsManagedExternally:    &isManagedExternally,
+		Owners:                 owners,
 	})
 
 	if err != nil {
@@ -105,12 +344,24 @@ func (r resourceChart) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	result := &Chart{
-		Id:          types.Int64{Value: int64(*res.JSON201.Id)},
-		Title:       types.String{Value: res.JSON201.Result.SliceName},
-		DatasetId:   chart.DatasetId,
-		DashboardId: chart.DashboardId,
-		VizType:     types.String{Value: *res.JSON201.Result.VizType},
-		Params:      chart.Params,
+		Id:             types.Int64{Value: int64(*res.JSON201.Id)},
+		Title:          types.String{Value: res.JSON201.Result.SliceName},
+		DatasetId:      chart.DatasetId,
+		DatasourceType: chart.DatasourceType,
+		DashboardIds:   chart.DashboardIds,
+		VizType:        types.String{Value: *res.JSON201.Result.VizType},
+		Params:         chart.Params,
+		OwnerIds:       chart.OwnerIds,
+		IgnoredParams:  chart.IgnoredParams,
+
+		QueryContext:           chart.QueryContext,
+		QueryContextGeneration: chart.QueryContextGeneration,
+
+		Description:          chart.Description,
+		CacheTimeout:         chart.CacheTimeout,
+		CertifiedBy:          chart.CertifiedBy,
+		CertificationDetails: chart.CertificationDetails,
+		ExternalUrl:          chart.ExternalUrl,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -132,31 +383,113 @@ func (r resourceChart) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error updating chart",
-			"Could not update chart, unexpected error: "+err.Error(),
+			"Error reading chart",
+			"Could not read chart, unexpected error: "+err.Error(),
 		)
 
 		return
 	}
 
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
 	if res.StatusCode() != 200 {
 		resp.Diagnostics.AddError(
-			"Error creating chart",
+			"Error reading chart",
 			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
 		)
 
 		return
 	}
 
+	ignoredParams := defaultChartIgnoredParams
+
+	if !chart.IgnoredParams.Null && !chart.IgnoredParams.Unknown {
+		diags = chart.IgnoredParams.ElementsAs(ctx, &ignoredParams, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	result := &Chart{
-		Id:          types.Int64{Value: int64(*res.JSON200.Id)},
-		Title:       types.String{Value: *res.JSON200.Result.SliceName},
-		DatasetId:   chart.DatasetId,
-		DashboardId: chart.DashboardId,
-		VizType:     types.String{Value: *res.JSON200.Result.VizType},
-		Params:      chart.Params,
+		Id:             types.Int64{Value: int64(*res.JSON200.Id)},
+		Title:          types.String{Value: *res.JSON200.Result.SliceName},
+		DatasetId:      chart.DatasetId,
+		DatasourceType: chart.DatasourceType,
+		VizType:        types.String{Value: *res.JSON200.Result.VizType},
+		Params:         chart.Params,
+		IgnoredParams:  chart.IgnoredParams,
+
+		QueryContext:           types.String{Null: true},
+		QueryContextGeneration: chart.QueryContextGeneration,
+
+		Description:          optionalStringFromPointer(res.JSON200.Result.Description, chart.Description),
+		CacheTimeout:         types.Int64{Null: true},
+		CertifiedBy:          optionalStringFromPointer(res.JSON200.Result.CertifiedBy, chart.CertifiedBy),
+		CertificationDetails: optionalStringFromPointer(res.JSON200.Result.CertificationDetails, chart.CertificationDetails),
+		// external_url is not part of the chart GET response
+		ExternalUrl: chart.ExternalUrl,
+	}
+
+	if res.JSON200.Result.CacheTimeout != nil {
+		result.CacheTimeout = types.Int64{Value: int64(*res.JSON200.Result.CacheTimeout)}
 	}
 
+	if res.JSON200.Result.QueryContext != nil {
+		result.QueryContext = jsonStringFromResponse(*res.JSON200.Result.QueryContext, chart.QueryContext)
+	}
+
+	if res.JSON200.Result.Params != nil {
+		result.Params = chartParamsFromResponse(*res.JSON200.Result.Params, chart.Params, ignoredParams)
+	}
+
+	datasource, err := getChartDatasource(ctx, r.p.client, int(chart.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading chart",
+			"Could not read chart datasource, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if datasource != nil && datasource.DatasourceId != nil {
+		result.DatasetId = types.Int64{Value: int64(*datasource.DatasourceId)}
+	}
+
+	if datasource != nil && datasource.DatasourceType != nil {
+		result.DatasourceType = types.String{Value: *datasource.DatasourceType}
+	}
+
+	var ownerIds []int64
+
+	if res.JSON200.Result.Owners != nil {
+		for _, owner := range *res.JSON200.Result.Owners {
+			if owner.Id != nil {
+				ownerIds = append(ownerIds, int64(*owner.Id))
+			}
+		}
+	}
+
+	result.OwnerIds = int64SetFromIds(ownerIds, chart.OwnerIds)
+
+	var dashboardIds []int64
+
+	if res.JSON200.Result.Dashboards != nil {
+		for _, dashboard := range *res.JSON200.Result.Dashboards {
+			if dashboard.Id != nil {
+				dashboardIds = append(dashboardIds, int64(*dashboard.Id))
+			}
+		}
+	}
+
+	result.DashboardIds = int64SetFromIds(dashboardIds, chart.DashboardIds)
+
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -179,18 +512,47 @@ func (r resourceChart) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	owners, diags := int32SlicePointer(ctx, chart.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboards, diags := int32SlicePointer(ctx, chart.DashboardIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the query context could not be generated at plan time when the dataset
+	// or params were not known yet
+	if chart.QueryContext.Unknown {
+		chart.QueryContext, diags = generateChartQueryContext(chart)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	isManagedExternally := true
 	datasourceId := int32(chart.DatasetId.Value)
-	dashboardId := int32(chart.DashboardId.Value)
-	var datasourceType client.ChartRestApiPutDatasourceType = "table"
+	datasourceType := client.ChartRestApiPutDatasourceType(chart.DatasourceType.Value)
 	res, err := r.p.client.PutApiV1ChartPkWithResponse(ctx, int(state.Id.Value), client.PutApiV1ChartPkJSONRequestBody{
-		SliceName:           &chart.Title.Value,
-		DatasourceId:        &datasourceId,
-		DatasourceType:      &datasourceType,
-		Dashboards:          &[]int32{dashboardId},
-		VizType:             &chart.VizType.Value,
-		Params:              &chart.Params.Value,
-		IsManagedExternally: &isManagedExternally,
+		SliceName:              &chart.Title.Value,
+		DatasourceId:           &datasourceId,
+		DatasourceType:         &datasourceType,
+		Dashboards:             dashboards,
+		VizType:                &chart.VizType.Value,
+		Params:                 &chart.Params.Value,
+		QueryContext:           stringPointer(chart.QueryContext),
+		QueryContextGeneration: boolPointer(chart.QueryContextGeneration),
+		Description:            emptyStringPointer(chart.Description),
+		CacheTimeout:           int32Pointer(chart.CacheTimeout),
+		CertifiedBy:            emptyStringPointer(chart.CertifiedBy),
+		CertificationDetails:   emptyStringPointer(chart.CertificationDetails),
+		ExternalUrl:            emptyStringPointer(chart.ExternalUrl),
+		IsManagedExternally:    &isManagedExternally,
+		Owners:                 owners,
 	})
 
 	if err != nil {
@@ -212,12 +574,24 @@ func (r resourceChart) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	result := &Chart{
-		Id:          types.Int64{Value: int64(*res.JSON200.Id)},
-		Title:       types.String{Value: *res.JSON200.Result.SliceName},
-		DatasetId:   chart.DatasetId,
-		DashboardId: chart.DashboardId,
-		VizType:     types.String{Value: *res.JSON200.Result.VizType},
-		Params:      chart.Params,
+		Id:             types.Int64{Value: int64(*res.JSON200.Id)},
+		Title:          types.String{Value: *res.JSON200.Result.SliceName},
+		DatasetId:      chart.DatasetId,
+		DatasourceType: chart.DatasourceType,
+		DashboardIds:   chart.DashboardIds,
+		VizType:        types.String{Value: *res.JSON200.Result.VizType},
+		Params:         chart.Params,
+		OwnerIds:       chart.OwnerIds,
+		IgnoredParams:  chart.IgnoredParams,
+
+		QueryContext:           chart.QueryContext,
+		QueryContextGeneration: chart.QueryContextGeneration,
+
+		Description:          chart.Description,
+		CacheTimeout:         chart.CacheTimeout,
+		CertifiedBy:          chart.CertifiedBy,
+		CertificationDetails: chart.CertificationDetails,
+		ExternalUrl:          chart.ExternalUrl,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -253,3 +627,155 @@ func (r resourceChart) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
+
+// UpgradeState moves the single dashboard_id of version 0 into dashboard_ids.
+func (r resourceChart) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"id": {
+						Computed: true,
+						Type:     types.Int64Type,
+					},
+					"title": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"dashboard_id": {
+						Required: true,
+						Type:     types.Int64Type,
+					},
+					"dataset_id": {
+						Required: true,
+						Type:     types.Int64Type,
+					},
+					"viz_type": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"params": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"owner_ids": {
+						Optional: true,
+						Type:     types.SetType{ElemType: types.Int64Type},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior ChartV0
+				diags := req.State.Get(ctx, &prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				dashboardIds := types.Set{ElemType: types.Int64Type, Null: true}
+
+				if !prior.DashboardId.Null {
+					dashboardIds = types.Set{ElemType: types.Int64Type, Elems: []attr.Value{prior.DashboardId}}
+				}
+
+				result := &Chart{
+					Id:             prior.Id,
+					Title:          prior.Title,
+					DashboardIds:   dashboardIds,
+					DatasetId:      prior.DatasetId,
+					DatasourceType: types.String{Value: "table"},
+					VizType:        prior.VizType,
+					Params:         prior.Params,
+					OwnerIds:       prior.OwnerIds,
+					IgnoredParams:  types.Set{ElemType: types.StringType, Null: true},
+
+					QueryContext:           types.String{Null: true},
+					QueryContextGeneration: types.Bool{Null: true},
+
+					Description:          types.String{Null: true},
+					CacheTimeout:         types.Int64{Null: true},
+					CertifiedBy:          types.String{Null: true},
+					CertificationDetails: types.String{Null: true},
+					ExternalUrl:          types.String{Null: true},
+				}
+
+				diags = resp.State.Set(ctx, result)
+				resp.Diagnostics.Append(diags...)
+			},
+		},
+	}
+}
+
+// getChartDatasource reads the datasource of a chart from the chart list,
+// because the chart detail endpoint does not return it. Returns nil when the
+// chart is not listed.
+func getChartDatasource(ctx context.Context, c *client.ClientWithResponses, chartId int) (*client.ChartRestApiGetList, error) {
+	res, err := c.GetApiV1ChartWithResponse(ctx, &client.GetApiV1ChartParams{
+		Q: &client.GetListSchema{
+			Columns: &[]string{"id", "datasource_id", "datasource_type"},
+			Filters: &[]struct {
+				Col   string      `json:"col"`
+				Opr   string      `json:"opr"`
+				Value interface{} `json:"value"`
+			}{
+				{Col: "id", Opr: "eq", Value: chartId},
+			},
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != 200 {
+		return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	if res.JSON200.Result == nil {
+		return nil, nil
+	}
+
+	for _, chart := range *res.JSON200.Result {
+		if chart.Id != nil && int(*chart.Id) == chartId {
+			return &chart, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// chartParamsFromResponse compares params semantically, ignoring the given
+// top level keys. Prior state is kept when nothing else changed, otherwise
+// the response is returned with the ignored keys taken from prior state so
+// that the plan only shows meaningful changes.
+func chartParamsFromResponse(v string, prior types.String, ignored []string) types.String {
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &params); err != nil {
+		return jsonStringFromResponse(v, prior)
+	}
+
+	var priorParams map[string]interface{}
+	if err := json.Unmarshal([]byte(prior.Value), &priorParams); err != nil {
+		return jsonStringFromResponse(v, prior)
+	}
+
+	for _, key := range ignored {
+		delete(params, key)
+
+		if value, ok := priorParams[key]; ok {
+			params[key] = value
+		}
+	}
+
+	if reflect.DeepEqual(params, priorParams) {
+		return prior
+	}
+
+	result, err := json.Marshal(params)
+
+	if err != nil {
+		return jsonStringFromResponse(v, prior)
+	}
+
+	return types.String{Value: string(result)}
+}
@@ -0,0 +1,255 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type CssTemplate struct {
+	Id           types.Int64  `tfsdk:"id"`
+	TemplateName types.String `tfsdk:"template_name"`
+	Css          types.String `tfsdk:"css"`
+}
+
+type resourceCssTemplateType struct{}
+
+func (r resourceCssTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"template_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"css": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r resourceCssTemplateType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceCssTemplate{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceCssTemplate struct {
+	p presetProvider
+}
+
+func (r resourceCssTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var template CssTemplate
+	diags := req.Plan.Get(ctx, &template)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PostApiV1CssTemplateWithResponse(ctx, client.PostApiV1CssTemplateJSONRequestBody{
+		TemplateName: &template.TemplateName.Value,
+		Css:          &template.Css.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating css template",
+			"Could not create css template, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating css template",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := &CssTemplate{
+		Id:           types.Int64{Value: int64(*res.JSON201.Id)},
+		TemplateName: template.TemplateName,
+		Css:          template.Css,
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceCssTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CssTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1CssTemplatePkWithResponse(ctx, int(state.Id.Value), &client.GetApiV1CssTemplatePkParams{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading css template",
+			"Could not read css template, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading css template",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := cssTemplateFromResponse(state.Id.Value, res.JSON200.Result.TemplateName, res.JSON200.Result.Css)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceCssTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var template CssTemplate
+	diags := req.Plan.Get(ctx, &template)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CssTemplate
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PutApiV1CssTemplatePkWithResponse(ctx, int(state.Id.Value), client.PutApiV1CssTemplatePkJSONRequestBody{
+		TemplateName: &template.TemplateName.Value,
+		Css:          &template.Css.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating css template",
+			"Could not update css template, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating css template",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := &CssTemplate{
+		Id:           state.Id,
+		TemplateName: template.TemplateName,
+		Css:          template.Css,
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceCssTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CssTemplate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1CssTemplatePkWithResponse(ctx, int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting css template",
+			"Could not delete css template, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting css template",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts the css template id.
+func (r resourceCssTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing css template",
+			"Could not parse css template id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func cssTemplateFromResponse(id int64, templateName *string, css *string) *CssTemplate {
+	result := &CssTemplate{
+		Id:           types.Int64{Value: id},
+		TemplateName: types.String{Value: ""},
+		Css:          types.String{Value: ""},
+	}
+
+	if templateName != nil {
+		result.TemplateName = types.String{Value: *templateName}
+	}
+
+	if css != nil {
+		result.Css = types.String{Value: *css}
+	}
+
+	return result
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,10 +15,22 @@ import (
 )
 
 type Dashboard struct {
-	Id     types.Int64  `tfsdk:"id"`
-	Title  types.String `tfsdk:"title"`
-	Slug   types.String `tfsdk:"slug"`
-	Status types.String `tfsdk:"status"`
+	Id                       types.Int64  `tfsdk:"id"`
+	Title                    types.String `tfsdk:"title"`
+	Slug                     types.String `tfsdk:"slug"`
+	Status                   types.String `tfsdk:"status"`
+	Css                      types.String `tfsdk:"css"`
+	ColorScheme              types.String `tfsdk:"color_scheme"`
+	LabelColors              types.Map    `tfsdk:"label_colors"`
+	RefreshFrequency         types.Int64  `tfsdk:"refresh_frequency"`
+	TimedRefreshImmuneSlices types.List   `tfsdk:"timed_refresh_immune_slices"`
+	CrossFiltersEnabled      types.Bool   `tfsdk:"cross_filters_enabled"`
+	CertifiedBy              types.String `tfsdk:"certified_by"`
+	CertificationDetails     types.String `tfsdk:"certification_details"`
+	ExternalUrl              types.String `tfsdk:"external_url"`
+	OwnerIds                 types.Set    `tfsdk:"owner_ids"`
+	RoleIds                  types.Set    `tfsdk:"role_ids"`
+	DeletionPolicy           types.String `tfsdk:"deletion_policy"`
 }
 
 type resourceDashboardType struct{}
@@ -48,6 +62,63 @@ func (r resourceDashboardType) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 					),
 				},
 			},
+			"css": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"color_scheme": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"label_colors": {
+				Optional:    true,
+				Type:        types.MapType{ElemType: types.StringType},
+				Description: "A map of series labels to the color used for them on every chart of the dashboard.",
+			},
+			"refresh_frequency": {
+				Optional:    true,
+				Type:        types.Int64Type,
+				Description: "How often the dashboard refreshes, in seconds. 0 disables the automatic refresh.",
+			},
+			"timed_refresh_immune_slices": {
+				Optional:    true,
+				Type:        types.ListType{ElemType: types.Int64Type},
+				Description: "Ids of charts that are not refreshed by `refresh_frequency`.",
+			},
+			"cross_filters_enabled": {
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"certified_by": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"certification_details": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"external_url": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"owner_ids": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.Int64Type},
+				Description: "Ids of the users that own the dashboard. See the `preset_user` data source.",
+			},
+			"role_ids": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.Int64Type},
+				Description: "Ids of the roles that can access the dashboard when dashboard role based access control is enabled. See the `preset_role` data source.",
+			},
+			"deletion_policy": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "What to do when the dashboard is destroyed while charts not managed by Terraform are still on it: `block` (default) refuses, `warn` deletes it anyway and `cascade` deletes those charts too.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("block", "warn", "cascade"),
+				},
+			},
 		},
 	}, nil
 }
@@ -71,13 +142,40 @@ func (r resourceDashboard) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	jsonMetadata := gabs.New()
+	diags = setDashboardJsonMetadata(ctx, jsonMetadata, &dashboard)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owners, diags := int32SlicePointer(ctx, dashboard.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := int32SlicePointer(ctx, dashboard.RoleIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serializedJsonMetadataString := jsonMetadata.String()
 	isManagedExternally := true
 	isPublished := dashboard.Status.Value == "published"
 	res, err := r.p.client.PostApiV1DashboardWithResponse(ctx, client.PostApiV1DashboardJSONRequestBody{
-		DashboardTitle:      &dashboard.Title.Value,
-		Slug:                &dashboard.Slug.Value,
-		IsManagedExternally: &isManagedExternally,
-		Published:           &isPublished,
+		DashboardTitle:       &dashboard.Title.Value,
+		Slug:                 &dashboard.Slug.Value,
+		IsManagedExternally:  &isManagedExternally,
+		Published:            &isPublished,
+		JsonMetadata:         &serializedJsonMetadataString,
+		Css:                  stringPointer(dashboard.Css),
+		CertifiedBy:          stringPointer(dashboard.CertifiedBy),
+		CertificationDetails: stringPointer(dashboard.CertificationDetails),
+		ExternalUrl:          stringPointer(dashboard.ExternalUrl),
+		Owners:               owners,
+		Roles:                roles,
 	})
 
 	if err != nil {
@@ -107,10 +205,22 @@ func (r resourceDashboard) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	result := &Dashboard{
-		Id:     types.Int64{Value: int64(*res.JSON201.Id)},
-		Title:  types.String{Value: *res.JSON201.Result.DashboardTitle},
-		Slug:   types.String{Value: *res.JSON201.Result.Slug},
-		Status: types.String{Value: status},
+		Id:                       types.Int64{Value: int64(*res.JSON201.Id)},
+		Title:                    types.String{Value: *res.JSON201.Result.DashboardTitle},
+		Slug:                     types.String{Value: *res.JSON201.Result.Slug},
+		Status:                   types.String{Value: status},
+		Css:                      dashboard.Css,
+		ColorScheme:              dashboard.ColorScheme,
+		LabelColors:              dashboard.LabelColors,
+		RefreshFrequency:         dashboard.RefreshFrequency,
+		TimedRefreshImmuneSlices: dashboard.TimedRefreshImmuneSlices,
+		CrossFiltersEnabled:      dashboard.CrossFiltersEnabled,
+		CertifiedBy:              dashboard.CertifiedBy,
+		CertificationDetails:     dashboard.CertificationDetails,
+		ExternalUrl:              dashboard.ExternalUrl,
+		OwnerIds:                 dashboard.OwnerIds,
+		RoleIds:                  dashboard.RoleIds,
+		DeletionPolicy:           dashboard.DeletionPolicy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -157,12 +267,54 @@ func (r resourceDashboard) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	result := &Dashboard{
-		Id:     types.Int64{Value: int64(*res.JSON200.Result.Id)},
-		Title:  types.String{Value: *res.JSON200.Result.DashboardTitle},
-		Slug:   types.String{Value: *res.JSON200.Result.Slug},
-		Status: types.String{Value: status},
+		Id:                   types.Int64{Value: int64(*res.JSON200.Result.Id)},
+		Title:                types.String{Value: *res.JSON200.Result.DashboardTitle},
+		Slug:                 types.String{Value: *res.JSON200.Result.Slug},
+		Status:               types.String{Value: status},
+		Css:                  optionalStringFromPointer(res.JSON200.Result.Css, state.Css),
+		CertifiedBy:          optionalStringFromPointer(res.JSON200.Result.CertifiedBy, state.CertifiedBy),
+		CertificationDetails: optionalStringFromPointer(res.JSON200.Result.CertificationDetails, state.CertificationDetails),
+		// external_url is not part of the dashboard GET response
+		ExternalUrl:    state.ExternalUrl,
+		DeletionPolicy: state.DeletionPolicy,
 	}
 
+	var ownerIds []int64
+
+	if res.JSON200.Result.Owners != nil {
+		for _, owner := range *res.JSON200.Result.Owners {
+			ownerIds = append(ownerIds, int64(*owner.Id))
+		}
+	}
+
+	var roleIds []int64
+
+	if res.JSON200.Result.Roles != nil {
+		for _, role := range *res.JSON200.Result.Roles {
+			roleIds = append(roleIds, int64(*role.Id))
+		}
+	}
+
+	result.OwnerIds = int64SetFromIds(ownerIds, state.OwnerIds)
+	result.RoleIds = int64SetFromIds(roleIds, state.RoleIds)
+
+	jsonMetadata := gabs.New()
+
+	if res.JSON200.Result.JsonMetadata != nil && *res.JSON200.Result.JsonMetadata != "" {
+		jsonMetadata, err = gabs.ParseJSON([]byte(*res.JSON200.Result.JsonMetadata))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading dashboard",
+				"Could not parse json_metadata, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	readDashboardJsonMetadata(jsonMetadata, &state, result)
+
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -185,13 +337,57 @@ func (r resourceDashboard) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	dashboardJsonMetadataMutex.Lock()
+	defer dashboardJsonMetadataMutex.Unlock()
+
+	jsonMetadata, err := getDashboardJsonMetadata(ctx, r.p.client, state.Id.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dashboard",
+			"Could not read dashboard json_metadata, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if jsonMetadata == nil {
+		jsonMetadata = gabs.New()
+	}
+
+	diags = setDashboardJsonMetadata(ctx, jsonMetadata, &dashboard)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owners, diags := int32SlicePointer(ctx, dashboard.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := int32SlicePointer(ctx, dashboard.RoleIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serializedJsonMetadataString := jsonMetadata.String()
 	isManagedExternally := true
 	isPublished := dashboard.Status.Value == "published"
 	res, err := r.p.client.PutApiV1DashboardPkWithResponse(ctx, int(state.Id.Value), client.PutApiV1DashboardPkJSONRequestBody{
-		DashboardTitle:      &dashboard.Title.Value,
-		Slug:                &dashboard.Slug.Value,
-		IsManagedExternally: &isManagedExternally,
-		Published:           &isPublished,
+		DashboardTitle:       &dashboard.Title.Value,
+		Slug:                 &dashboard.Slug.Value,
+		IsManagedExternally:  &isManagedExternally,
+		Published:            &isPublished,
+		JsonMetadata:         &serializedJsonMetadataString,
+		Css:                  emptyStringPointer(dashboard.Css),
+		CertifiedBy:          emptyStringPointer(dashboard.CertifiedBy),
+		CertificationDetails: emptyStringPointer(dashboard.CertificationDetails),
+		ExternalUrl:          emptyStringPointer(dashboard.ExternalUrl),
+		Owners:               owners,
+		Roles:                roles,
 	})
 
 	if err != nil {
@@ -221,10 +417,22 @@ func (r resourceDashboard) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	result := &Dashboard{
-		Id:     types.Int64{Value: int64(*res.JSON200.Id)},
-		Title:  types.String{Value: *res.JSON200.Result.DashboardTitle},
-		Slug:   types.String{Value: *res.JSON200.Result.Slug},
-		Status: types.String{Value: status},
+		Id:                       types.Int64{Value: int64(*res.JSON200.Id)},
+		Title:                    types.String{Value: *res.JSON200.Result.DashboardTitle},
+		Slug:                     types.String{Value: *res.JSON200.Result.Slug},
+		Status:                   types.String{Value: status},
+		Css:                      dashboard.Css,
+		ColorScheme:              dashboard.ColorScheme,
+		LabelColors:              dashboard.LabelColors,
+		RefreshFrequency:         dashboard.RefreshFrequency,
+		TimedRefreshImmuneSlices: dashboard.TimedRefreshImmuneSlices,
+		CrossFiltersEnabled:      dashboard.CrossFiltersEnabled,
+		CertifiedBy:              dashboard.CertifiedBy,
+		CertificationDetails:     dashboard.CertificationDetails,
+		ExternalUrl:              dashboard.ExternalUrl,
+		OwnerIds:                 dashboard.OwnerIds,
+		RoleIds:                  dashboard.RoleIds,
+		DeletionPolicy:           dashboard.DeletionPolicy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -242,6 +450,22 @@ func (r resourceDashboard) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	related, err := getDashboardRelatedObjects(ctx, r.p.client, state.Id.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dashboard",
+			"Could not read the charts of the dashboard, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(applyDeletionPolicy(ctx, r.p.client, state.DeletionPolicy, "dashboard", related)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.p.client.DeleteApiV1DashboardPkWithResponse(ctx, int(state.Id.Value))
 
 	if err != nil {
@@ -249,6 +473,8 @@ func (r resourceDashboard) Delete(ctx context.Context, req resource.DeleteReques
 			"Error deleting dashboard",
 			"Could not delete dashboard, unexpected error: "+err.Error(),
 		)
+
+		return
 	}
 
 	if res.StatusCode() != 200 {
@@ -260,3 +486,126 @@ func (r resourceDashboard) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 }
+
+// getDashboardRelatedObjects returns the charts still on a dashboard.
+func getDashboardRelatedObjects(ctx context.Context, c *client.ClientWithResponses, dashboardId int64) (relatedObjects, error) {
+	var related relatedObjects
+
+	res, err := c.GetApiV1DashboardIdOrSlugChartsWithResponse(ctx, fmt.Sprint(dashboardId))
+
+	if err != nil {
+		return related, err
+	}
+
+	if res.StatusCode() != 200 {
+		return related, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	if res.JSON200.Result != nil {
+		for _, chart := range *res.JSON200.Result {
+			if chart.SliceId != nil {
+				related.Charts = append(related.Charts, newRelatedObject(*chart.SliceId, chart.SliceName))
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// setDashboardJsonMetadata writes the json_metadata keys managed by
+// preset_dashboard into jm and removes the keys of null attributes, so that
+// removing an attribute from the configuration clears it. Other keys, such as
+// native_filter_configuration, are left untouched.
+func setDashboardJsonMetadata(ctx context.Context, jm *gabs.Container, dashboard *Dashboard) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !dashboard.ColorScheme.Null {
+		jm.Set(dashboard.ColorScheme.Value, "color_scheme")
+	} else {
+		jm.Delete("color_scheme")
+	}
+
+	if !dashboard.LabelColors.Null {
+		labelColors := map[string]string{}
+		diags.Append(dashboard.LabelColors.ElementsAs(ctx, &labelColors, false)...)
+		jm.Set(labelColors, "label_colors")
+	} else {
+		jm.Delete("label_colors")
+	}
+
+	if !dashboard.RefreshFrequency.Null {
+		jm.Set(dashboard.RefreshFrequency.Value, "refresh_frequency")
+	} else {
+		jm.Delete("refresh_frequency")
+	}
+
+	if !dashboard.TimedRefreshImmuneSlices.Null {
+		slices := []int64{}
+		diags.Append(dashboard.TimedRefreshImmuneSlices.ElementsAs(ctx, &slices, false)...)
+		jm.Set(slices, "timed_refresh_immune_slices")
+	} else {
+		jm.Delete("timed_refresh_immune_slices")
+	}
+
+	if !dashboard.CrossFiltersEnabled.Null {
+		jm.Set(dashboard.CrossFiltersEnabled.Value, "cross_filters_enabled")
+	} else {
+		jm.Delete("cross_filters_enabled")
+	}
+
+	return diags
+}
+
+// readDashboardJsonMetadata sets the json_metadata attributes of result,
+// keeping attributes that are null in state null.
+func readDashboardJsonMetadata(jm *gabs.Container, state *Dashboard, result *Dashboard) {
+	result.ColorScheme = types.String{Null: true}
+	result.LabelColors = types.Map{ElemType: types.StringType, Null: true}
+	result.RefreshFrequency = types.Int64{Null: true}
+	result.TimedRefreshImmuneSlices = types.List{ElemType: types.Int64Type, Null: true}
+	result.CrossFiltersEnabled = types.Bool{Null: true}
+
+	if !state.ColorScheme.Null {
+		if v, ok := jm.Path("color_scheme").Data().(string); ok {
+			result.ColorScheme = types.String{Value: v}
+		}
+	}
+
+	if !state.LabelColors.Null {
+		if v, ok := jm.Path("label_colors").Data().(map[string]interface{}); ok {
+			elems := map[string]attr.Value{}
+
+			for label, color := range v {
+				elems[label] = types.String{Value: fmt.Sprint(color)}
+			}
+
+			result.LabelColors = types.Map{ElemType: types.StringType, Elems: elems}
+		}
+	}
+
+	if !state.RefreshFrequency.Null {
+		if v, ok := jm.Path("refresh_frequency").Data().(float64); ok {
+			result.RefreshFrequency = types.Int64{Value: int64(v)}
+		}
+	}
+
+	if !state.TimedRefreshImmuneSlices.Null {
+		if v, ok := jm.Path("timed_refresh_immune_slices").Data().([]interface{}); ok {
+			elems := []attr.Value{}
+
+			for _, slice := range v {
+				if id, ok := slice.(float64); ok {
+					elems = append(elems, types.Int64{Value: int64(id)})
+				}
+			}
+
+			result.TimedRefreshImmuneSlices = types.List{ElemType: types.Int64Type, Elems: elems}
+		}
+	}
+
+	if !state.CrossFiltersEnabled.Null {
+		if v, ok := jm.Path("cross_filters_enabled").Data().(bool); ok {
+			result.CrossFiltersEnabled = types.Bool{Value: v}
+		}
+	}
+}
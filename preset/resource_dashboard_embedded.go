@@ -0,0 +1,262 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type DashboardEmbedded struct {
+	Id             types.Int64  `tfsdk:"id"`
+	DashboardId    types.Int64  `tfsdk:"dashboard_id"`
+	AllowedDomains types.List   `tfsdk:"allowed_domains"`
+	Uuid           types.String `tfsdk:"uuid"`
+}
+
+type resourceDashboardEmbeddedType struct{}
+
+func (r resourceDashboardEmbeddedType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"dashboard_id": {
+				Required:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"allowed_domains": {
+				Required:    true,
+				Type:        types.ListType{ElemType: types.StringType},
+				Description: "The domains allowed to embed the dashboard. An empty list allows any domain.",
+			},
+			"uuid": {
+				Computed:      true,
+				Type:          types.StringType,
+				Description:   "The embedded dashboard id passed to the embedded SDK.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+		},
+	}, nil
+}
+
+func (r resourceDashboardEmbeddedType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceDashboardEmbedded{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceDashboardEmbedded struct {
+	p presetProvider
+}
+
+func (r resourceDashboardEmbedded) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var embedded DashboardEmbedded
+	diags := req.Plan.Get(ctx, &embedded)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedDomains := []string{}
+	diags = embedded.AllowedDomains.ElementsAs(ctx, &allowedDomains, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PostApiV1DashboardIdOrSlugEmbeddedWithResponse(ctx, fmt.Sprint(embedded.DashboardId.Value), client.PostApiV1DashboardIdOrSlugEmbeddedJSONRequestBody{
+		AllowedDomains: allowedDomains,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating embedded dashboard",
+			"Could not create embedded dashboard, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error creating embedded dashboard",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := dashboardEmbeddedFromResponse(embedded.DashboardId, res.JSON200.Result)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardEmbedded) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardEmbedded
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1DashboardIdOrSlugEmbeddedWithResponse(ctx, fmt.Sprint(state.DashboardId.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading embedded dashboard",
+			"Could not read embedded dashboard, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// embedding was disabled in the UI
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading embedded dashboard",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := dashboardEmbeddedFromResponse(state.DashboardId, res.JSON200.Result)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardEmbedded) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var embedded DashboardEmbedded
+	diags := req.Plan.Get(ctx, &embedded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedDomains := []string{}
+	diags = embedded.AllowedDomains.ElementsAs(ctx, &allowedDomains, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PutApiV1DashboardIdOrSlugEmbeddedWithResponse(ctx, fmt.Sprint(embedded.DashboardId.Value), client.PutApiV1DashboardIdOrSlugEmbeddedJSONRequestBody{
+		AllowedDomains: allowedDomains,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating embedded dashboard",
+			"Could not update embedded dashboard, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating embedded dashboard",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	result := dashboardEmbeddedFromResponse(embedded.DashboardId, res.JSON200.Result)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardEmbedded) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardEmbedded
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1DashboardIdOrSlugEmbeddedWithResponse(ctx, fmt.Sprint(state.DashboardId.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting embedded dashboard",
+			"Could not delete embedded dashboard, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting embedded dashboard",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts the dashboard id.
+func (r resourceDashboardEmbedded) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	dashboardId, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing embedded dashboard",
+			"Could not parse dashboard id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), dashboardId)...)
+}
+
+func dashboardEmbeddedFromResponse(dashboardId types.Int64, embedded *client.EmbeddedDashboardResponseSchema) *DashboardEmbedded {
+	allowedDomains := []attr.Value{}
+
+	if embedded.AllowedDomains != nil {
+		for _, domain := range *embedded.AllowedDomains {
+			allowedDomains = append(allowedDomains, types.String{Value: domain})
+		}
+	}
+
+	return &DashboardEmbedded{
+		Id:             dashboardId,
+		DashboardId:    dashboardId,
+		AllowedDomains: types.List{ElemType: types.StringType, Elems: allowedDomains},
+		Uuid:           types.String{Value: *embedded.Uuid},
+	}
+}
@@ -2,10 +2,8 @@ package preset
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/rand"
-	"reflect"
 	"sync"
 
 	"github.com/Jeffail/gabs/v2"
@@ -24,9 +22,9 @@ type DashboardFilter struct {
 	Config      types.String `tfsdk:"config"`
 }
 
-// we can only create a single dashboard filter at any one time because filters may
-// update the same dashboard at any one time which can cause a race condition
-var resourceDashboardFilterMutex sync.Mutex
+// we can only update a single dashboard json_metadata at any one time because filters
+// and dashboards may update the same document at once which can cause a race condition
+var dashboardJsonMetadataMutex sync.Mutex
 
 type resourceDashboardFilterType struct{}
 
@@ -47,8 +45,9 @@ func (r resourceDashboardFilterType) GetSchema(_ context.Context) (tfsdk.Schema,
 				Type:     types.StringType,
 			},
 			"config": {
-				Required: true,
-				Type:     types.StringType,
+				Required:      true,
+				Type:          jsonStringType{},
+				PlanModifiers: tfsdk.AttributePlanModifiers{jsonSemanticEquality()},
 			},
 		},
 	}, nil
@@ -120,20 +119,7 @@ func (r resourceDashboardFilter) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	eq, err := isConfigEqual(state.Config.Value, filter.Config.Value)
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading dashboard filter",
-			"Could not find dashboard filter with id: "+err.Error(),
-		)
-
-		return
-	}
-
-	if eq {
-		filter.Config = state.Config
-	}
+	filter.Config = jsonStringFromResponse(filter.Config.Value, state.Config)
 
 	diags = resp.State.Set(ctx, filter)
 	resp.Diagnostics.Append(diags...)
@@ -199,8 +185,8 @@ func (r resourceDashboardFilter) Delete(ctx context.Context, req resource.Delete
 type jsonMetadata map[string]interface{}
 
 func upsertDashboardFilter(ctx context.Context, c *client.ClientWithResponses, dashboardId int64, filterId string, filter *DashboardFilter) error {
-	resourceDashboardFilterMutex.Lock()
-	defer resourceDashboardFilterMutex.Unlock()
+	dashboardJsonMetadataMutex.Lock()
+	defer dashboardJsonMetadataMutex.Unlock()
 
 	filters, jm, err := getDashboardFilters(ctx, c, dashboardId)
 
@@ -360,21 +346,3 @@ func mergeMaps(maps ...map[string]interface{}) map[string]interface{} {
 	}
 	return result
 }
-
-func isConfigEqual(configA string, configB string) (bool, error) {
-	var ca map[string]interface{}
-	err := json.Unmarshal([]byte(configA), &ca)
-
-	if err != nil {
-		return false, err
-	}
-
-	var cb map[string]interface{}
-	err = json.Unmarshal([]byte(configB), &cb)
-
-	if err != nil {
-		return false, err
-	}
-
-	return reflect.DeepEqual(ca, cb), nil
-}
@@ -0,0 +1,693 @@
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type DashboardFilterSet struct {
+	Id          types.Int64  `tfsdk:"id"`
+	DashboardId types.Int64  `tfsdk:"dashboard_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	OwnerType   types.String `tfsdk:"owner_type"`
+	OwnerId     types.Int64  `tfsdk:"owner_id"`
+	Values      types.Map    `tfsdk:"values"`
+}
+
+var dashboardFilterSetValuesType = types.MapType{ElemType: types.ListType{ElemType: types.StringType}}
+
+type resourceDashboardFilterSetType struct{}
+
+func (r resourceDashboardFilterSetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "A saved combination of native filter values on a dashboard.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"dashboard_id": {
+				Required:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"description": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"owner_type": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.StringType,
+				Description:   "Whether the filter set is shared on the dashboard or private to a user. Defaults to `Dashboard`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"Dashboard",
+						"User",
+					),
+				},
+			},
+			"owner_id": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.Int64Type,
+				Description:   "The id of the owning dashboard or user. Defaults to `dashboard_id` for dashboard owned filter sets.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown(), resource.RequiresReplace()},
+			},
+			"values": {
+				Required:    true,
+				Type:        dashboardFilterSetValuesType,
+				Description: "The selected values, keyed by native filter id. See `preset_dashboard_filter`. Value filters take the selected values, range filters a minimum and a maximum (empty for an open bound), and time range, time grain and time column filters a single value.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceDashboardFilterSetType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceDashboardFilterSet{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceDashboardFilterSet struct {
+	p presetProvider
+}
+
+// ModifyPlan checks the values against the dashboard's native filters. This
+// needs the API, so it cannot run in ValidateConfig.
+func (r resourceDashboardFilterSet) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.p.client == nil {
+		return
+	}
+
+	var plan DashboardFilterSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DashboardId.Unknown || plan.Values.Unknown {
+		return
+	}
+
+	values := map[string][]string{}
+	diags = plan.Values.ElementsAs(ctx, &values, false)
+
+	// individual values are not known yet
+	if diags.HasError() {
+		return
+	}
+
+	filters, _, err := getDashboardFilters(ctx, r.p.client, plan.DashboardId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("values"),
+			"Could not validate filter set values",
+			"Could not read dashboard native filters, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(validateDashboardFilterSetValues(filters, values)...)
+}
+
+func (r resourceDashboardFilterSet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var filterSet DashboardFilterSet
+	diags := req.Plan.Get(ctx, &filterSet)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if filterSet.OwnerType.Unknown || filterSet.OwnerType.Null {
+		filterSet.OwnerType = types.String{Value: "Dashboard"}
+	}
+
+	if filterSet.OwnerId.Unknown || filterSet.OwnerId.Null {
+		filterSet.OwnerId = filterSet.DashboardId
+	}
+
+	jsonMetadata, diags := r.dashboardFilterSetJsonMetadata(ctx, filterSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerId := int32(filterSet.OwnerId.Value)
+	res, err := r.p.client.PostApiV1DashboardDashboardIdFiltersetsWithResponse(ctx, int(filterSet.DashboardId.Value), client.PostApiV1DashboardDashboardIdFiltersetsJSONRequestBody{
+		Name:         filterSet.Name.Value,
+		Description:  stringPointer(filterSet.Description),
+		OwnerType:    client.FilterSetRestApiPostOwnerType(filterSet.OwnerType.Value),
+		OwnerId:      &ownerId,
+		JsonMetadata: jsonMetadata,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dashboard filter set",
+			"Could not create dashboard filter set, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating dashboard filter set",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	filterSet.Id = types.Int64{Value: int64(*res.JSON201.Id)}
+
+	diags = resp.State.Set(ctx, filterSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardFilterSet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardFilterSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1DashboardDashboardIdFiltersetsWithResponse(ctx, int(state.DashboardId.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard filter set",
+			"Could not read dashboard filter set, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard filter set",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	var result *DashboardFilterSet
+
+	if res.JSON200.Result != nil {
+		for _, v := range *res.JSON200.Result {
+			if v.Id == nil || int64(*v.Id) != state.Id.Value {
+				continue
+			}
+
+			result = &DashboardFilterSet{
+				Id:          state.Id,
+				DashboardId: state.DashboardId,
+				Name:        types.String{Value: ""},
+				Description: optionalStringFromPointer(v.Description, state.Description),
+				OwnerType:   state.OwnerType,
+				OwnerId:     state.OwnerId,
+			}
+
+			if v.Name != nil {
+				result.Name = types.String{Value: *v.Name}
+			}
+
+			if v.OwnerType != nil {
+				result.OwnerType = types.String{Value: *v.OwnerType}
+			}
+
+			if v.OwnerId != nil {
+				result.OwnerId = types.Int64{Value: int64(*v.OwnerId)}
+			}
+
+			jsonMetadata := ""
+
+			if v.JsonMetadata != nil {
+				jsonMetadata = *v.JsonMetadata
+			}
+
+			values, err := dashboardFilterSetValues(jsonMetadata)
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error reading dashboard filter set",
+					"Could not parse filter set json_metadata, unexpected error: "+err.Error(),
+				)
+
+				return
+			}
+
+			result.Values = values
+		}
+	}
+
+	if result == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardFilterSet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var filterSet DashboardFilterSet
+	diags := req.Plan.Get(ctx, &filterSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DashboardFilterSet
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if filterSet.OwnerType.Unknown || filterSet.OwnerType.Null {
+		filterSet.OwnerType = state.OwnerType
+	}
+
+	filterSet.Id = state.Id
+	filterSet.OwnerId = state.OwnerId
+
+	jsonMetadata, diags := r.dashboardFilterSetJsonMetadata(ctx, filterSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	description := ""
+
+	if !filterSet.Description.Null {
+		description = filterSet.Description.Value
+	}
+
+	ownerType := client.FilterSetRestApiPutOwnerType(filterSet.OwnerType.Value)
+	res, err := r.p.client.PutApiV1DashboardDashboardIdFiltersetsPkWithResponse(ctx, int(state.DashboardId.Value), int(state.Id.Value), client.PutApiV1DashboardDashboardIdFiltersetsPkJSONRequestBody{
+		Name:         &filterSet.Name.Value,
+		Description:  &description,
+		OwnerType:    &ownerType,
+		JsonMetadata: &jsonMetadata,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dashboard filter set",
+			"Could not update dashboard filter set, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating dashboard filter set",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, filterSet)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardFilterSet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardFilterSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1DashboardDashboardIdFiltersetsPkWithResponse(ctx, int(state.DashboardId.Value), int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dashboard filter set",
+			"Could not delete dashboard filter set, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting dashboard filter set",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts "dashboard_id/filter_set_id".
+func (r resourceDashboardFilterSet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Error importing dashboard filter set",
+			fmt.Sprintf("Expected an import id of the form dashboard_id/filter_set_id, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	dashboardId, err := strconv.ParseInt(parts[0], 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing dashboard filter set",
+			"Could not parse dashboard id: "+err.Error(),
+		)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing dashboard filter set",
+			"Could not parse filter set id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), dashboardId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// dashboardFilterSetJsonMetadata builds the filter set json_metadata in the
+// format written by the dashboard UI: a copy of the native filters and a data
+// mask holding the selected values of each filter.
+func (r resourceDashboardFilterSet) dashboardFilterSetJsonMetadata(ctx context.Context, filterSet DashboardFilterSet) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string][]string{}
+	diags.Append(filterSet.Values.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	filters, _, err := getDashboardFilters(ctx, r.p.client, filterSet.DashboardId.Value)
+
+	if err != nil {
+		diags.AddError(
+			"Error building dashboard filter set",
+			"Could not read dashboard native filters, unexpected error: "+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	diags.Append(validateDashboardFilterSetValues(filters, values)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	jsonMetadata := gabs.New()
+	jsonMetadata.Object("nativeFilters")
+	jsonMetadata.Object("dataMask")
+
+	for _, filter := range filters {
+		config, err := gabs.ParseJSON([]byte(filter.Config.Value))
+
+		if err != nil {
+			diags.AddError(
+				"Error building dashboard filter set",
+				"Could not parse native filter config, unexpected error: "+err.Error(),
+			)
+
+			return "", diags
+		}
+
+		config.Set(filter.Id.Value, "id")
+		jsonMetadata.Set(config.Data(), "nativeFilters", filter.Id.Value)
+
+		filterValues, ok := values[filter.Id.Value]
+
+		if !ok {
+			continue
+		}
+
+		dataMask := dashboardFilterSetDataMask(config, filterValues)
+		dataMask.Set(filter.Id.Value, "id")
+
+		jsonMetadata.Set(dataMask.Data(), "dataMask", filter.Id.Value)
+	}
+
+	return jsonMetadata.String(), diags
+}
+
+// dashboardFilterSetDataMask builds the data mask the dashboard UI saves for
+// each filter type: the selected values and the query extras they apply.
+// Values are checked by validateDashboardFilterSetValues first.
+func dashboardFilterSetDataMask(config *gabs.Container, values []string) *gabs.Container {
+	dataMask := gabs.New()
+	dataMask.Object("ownState")
+	dataMask.Object("extraFormData")
+
+	filterType, _ := config.Path("filterType").Data().(string)
+	column, hasColumn := config.Path("targets.0.column.name").Data().(string)
+
+	switch filterType {
+	case "filter_range":
+		bounds := []interface{}{nil, nil}
+		var filters []interface{}
+
+		for i, op := range []string{">=", "<="} {
+			if values[i] == "" {
+				continue
+			}
+
+			bound, _ := strconv.ParseFloat(values[i], 64)
+			bounds[i] = bound
+			filters = append(filters, map[string]interface{}{
+				"col": column,
+				"op":  op,
+				"val": bound,
+			})
+		}
+
+		dataMask.Set(bounds, "filterState", "value")
+
+		if hasColumn && len(filters) > 0 {
+			dataMask.Set(filters, "extraFormData", "filters")
+		}
+	case "filter_time":
+		dataMask.Set(values[0], "filterState", "value")
+		dataMask.Set(values[0], "extraFormData", "time_range")
+	case "filter_timegrain":
+		dataMask.Set(values, "filterState", "value")
+		dataMask.Set(values[0], "extraFormData", "time_grain_sqla")
+	case "filter_timecolumn":
+		dataMask.Set(values, "filterState", "value")
+		dataMask.Set(values[0], "extraFormData", "granularity_sqla")
+	default:
+		dataMask.Set(values, "filterState", "value")
+
+		if hasColumn {
+			dataMask.Set([]interface{}{
+				map[string]interface{}{
+					"col": column,
+					"op":  "IN",
+					"val": values,
+				},
+			}, "extraFormData", "filters")
+		}
+	}
+
+	return dataMask
+}
+
+// validateDashboardFilterSetValues checks that every value targets a native
+// filter on the dashboard, and that the values fit the type of the filter.
+func validateDashboardFilterSetValues(filters []*DashboardFilter, values map[string][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	filtersById := map[string]*DashboardFilter{}
+	var filterIds []string
+
+	for _, filter := range filters {
+		filtersById[filter.Id.Value] = filter
+		filterIds = append(filterIds, filter.Id.Value)
+	}
+
+	sort.Strings(filterIds)
+
+	for filterId, filterValues := range values {
+		filter, ok := filtersById[filterId]
+
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(filterId),
+				"Unknown native filter",
+				fmt.Sprintf("The dashboard has no native filter %s, expected one of: %s", filterId, strings.Join(filterIds, ", ")),
+			)
+
+			continue
+		}
+
+		config, err := gabs.ParseJSON([]byte(filter.Config.Value))
+
+		if err != nil {
+			continue
+		}
+
+		filterType, _ := config.Path("filterType").Data().(string)
+
+		switch filterType {
+		case "filter_select":
+			multiSelect, ok := config.Path("controlValues.multiSelect").Data().(bool)
+
+			if ok && !multiSelect && len(filterValues) > 1 {
+				diags.AddAttributeError(
+					path.Root("values").AtMapKey(filterId),
+					"Invalid native filter value",
+					fmt.Sprintf("Native filter %s (%s) only accepts a single value", filterId, filter.Name.Value),
+				)
+			}
+		case "filter_range":
+			if len(filterValues) != 2 {
+				diags.AddAttributeError(
+					path.Root("values").AtMapKey(filterId),
+					"Invalid native filter value",
+					fmt.Sprintf("Native filter %s (%s) is a range filter and takes a minimum and a maximum", filterId, filter.Name.Value),
+				)
+
+				continue
+			}
+
+			for i, v := range filterValues {
+				if _, err := strconv.ParseFloat(v, 64); v != "" && err != nil {
+					diags.AddAttributeError(
+						path.Root("values").AtMapKey(filterId).AtListIndex(i),
+						"Invalid native filter value",
+						fmt.Sprintf("Native filter %s (%s) is a range filter and only accepts numbers, or an empty bound", filterId, filter.Name.Value),
+					)
+				}
+			}
+		case "filter_time", "filter_timegrain", "filter_timecolumn":
+			if len(filterValues) != 1 {
+				diags.AddAttributeError(
+					path.Root("values").AtMapKey(filterId),
+					"Invalid native filter value",
+					fmt.Sprintf("Native filter %s (%s) only accepts a single value", filterId, filter.Name.Value),
+				)
+			}
+		default:
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(filterId),
+				"Unsupported native filter",
+				fmt.Sprintf("Native filter %s (%s) has the type %s, which filter sets do not support", filterId, filter.Name.Value, filterType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// dashboardFilterSetValues reads the selected values back from the data mask.
+func dashboardFilterSetValues(jsonMetadata string) (types.Map, error) {
+	result := types.Map{ElemType: dashboardFilterSetValuesType.ElemType, Elems: map[string]attr.Value{}}
+
+	if jsonMetadata == "" {
+		return result, nil
+	}
+
+	var metadata struct {
+		DataMask map[string]struct {
+			FilterState struct {
+				Value interface{} `json:"value"`
+			} `json:"filterState"`
+		} `json:"dataMask"`
+	}
+
+	err := json.Unmarshal([]byte(jsonMetadata), &metadata)
+
+	if err != nil {
+		return result, err
+	}
+
+	for filterId, dataMask := range metadata.DataMask {
+		if dataMask.FilterState.Value == nil {
+			continue
+		}
+
+		var elems []attr.Value
+
+		switch value := dataMask.FilterState.Value.(type) {
+		case []interface{}:
+			for _, v := range value {
+				elems = append(elems, dashboardFilterSetValue(v))
+			}
+		default:
+			elems = append(elems, dashboardFilterSetValue(value))
+		}
+
+		result.Elems[filterId] = types.List{ElemType: types.StringType, Elems: elems}
+	}
+
+	return result, nil
+}
+
+func dashboardFilterSetValue(v interface{}) types.String {
+	switch value := v.(type) {
+	case nil:
+		// an open bound of a range filter
+		return types.String{Value: ""}
+	case string:
+		return types.String{Value: value}
+	}
+
+	return types.String{Value: fmt.Sprint(v)}
+}
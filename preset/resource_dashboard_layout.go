@@ -0,0 +1,1169 @@
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+// Superset lays dashboards out on a grid that is 12 columns wide.
+const dashboardGridColumnCount = 12
+
+type DashboardLayoutComponent struct {
+	Type       types.String `tfsdk:"type"`
+	Key        types.String `tfsdk:"key"`
+	ChartId    types.Int64  `tfsdk:"chart_id"`
+	Text       types.String `tfsdk:"text"`
+	Code       types.String `tfsdk:"code"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	HeaderSize types.String `tfsdk:"header_size"`
+	Background types.String `tfsdk:"background"`
+}
+
+type DashboardLayoutRowComponent struct {
+	Type       types.String               `tfsdk:"type"`
+	Key        types.String               `tfsdk:"key"`
+	ChartId    types.Int64                `tfsdk:"chart_id"`
+	Text       types.String               `tfsdk:"text"`
+	Code       types.String               `tfsdk:"code"`
+	Width      types.Int64                `tfsdk:"width"`
+	Height     types.Int64                `tfsdk:"height"`
+	HeaderSize types.String               `tfsdk:"header_size"`
+	Background types.String               `tfsdk:"background"`
+	Components []DashboardLayoutComponent `tfsdk:"components"`
+}
+
+type DashboardLayoutGridComponent struct {
+	Type       types.String                  `tfsdk:"type"`
+	Key        types.String                  `tfsdk:"key"`
+	ChartId    types.Int64                   `tfsdk:"chart_id"`
+	Text       types.String                  `tfsdk:"text"`
+	Code       types.String                  `tfsdk:"code"`
+	Width      types.Int64                   `tfsdk:"width"`
+	Height     types.Int64                   `tfsdk:"height"`
+	HeaderSize types.String                  `tfsdk:"header_size"`
+	Background types.String                  `tfsdk:"background"`
+	Components []DashboardLayoutRowComponent `tfsdk:"components"`
+}
+
+type DashboardLayoutTab struct {
+	Key        types.String                   `tfsdk:"key"`
+	Title      types.String                   `tfsdk:"title"`
+	Components []DashboardLayoutGridComponent `tfsdk:"components"`
+}
+
+type DashboardLayout struct {
+	Id          types.Int64                    `tfsdk:"id"`
+	DashboardId types.Int64                    `tfsdk:"dashboard_id"`
+	Tabs        []DashboardLayoutTab           `tfsdk:"tabs"`
+	Components  []DashboardLayoutGridComponent `tfsdk:"components"`
+}
+
+// dashboardLayoutComponentAttributes returns the attributes shared by every
+// level of the layout. The levels only differ in which types they accept and
+// whether they can hold further components.
+func dashboardLayoutComponentAttributes(componentTypes []string, components *tfsdk.Attribute) map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{
+		"type": {
+			Required: true,
+			Type:     types.StringType,
+			Validators: []tfsdk.AttributeValidator{
+				stringOneOf(componentTypes...),
+			},
+		},
+		"key": {
+			Optional:    true,
+			Type:        types.StringType,
+			Description: "A stable identifier for the component. Components without a key are identified by their position, or by chart id for charts.",
+		},
+		"chart_id": {
+			Optional:    true,
+			Type:        types.Int64Type,
+			Description: "The chart to display. Required for `chart` components.",
+		},
+		"text": {
+			Optional:    true,
+			Type:        types.StringType,
+			Description: "The header text. Required for `header` components.",
+		},
+		"code": {
+			Optional:    true,
+			Type:        types.StringType,
+			Description: "The markdown content of `markdown` components.",
+		},
+		"width": {
+			Optional:    true,
+			Type:        types.Int64Type,
+			Description: "The width of `chart`, `markdown` and `column` components in grid columns, out of 12. Defaults to 4.",
+		},
+		"height": {
+			Optional:    true,
+			Type:        types.Int64Type,
+			Description: "The height of `chart` and `markdown` components in grid units. Defaults to 50.",
+		},
+		"header_size": {
+			Optional: true,
+			Type:     types.StringType,
+			Validators: []tfsdk.AttributeValidator{
+				stringOneOf(
+					"small",
+					"medium",
+					"large",
+				),
+			},
+		},
+		"background": {
+			Optional: true,
+			Type:     types.StringType,
+			Validators: []tfsdk.AttributeValidator{
+				stringOneOf(
+					"transparent",
+					"white",
+				),
+			},
+		},
+	}
+
+	if components != nil {
+		attributes["components"] = *components
+	}
+
+	return attributes
+}
+
+type resourceDashboardLayoutType struct{}
+
+func (r resourceDashboardLayoutType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	columnComponents := tfsdk.Attribute{
+		Optional:   true,
+		Attributes: tfsdk.ListNestedAttributes(dashboardLayoutComponentAttributes([]string{"chart", "markdown", "header", "divider"}, nil)),
+	}
+
+	rowComponents := tfsdk.Attribute{
+		Optional:   true,
+		Attributes: tfsdk.ListNestedAttributes(dashboardLayoutComponentAttributes([]string{"chart", "markdown", "column"}, &columnComponents)),
+	}
+
+	gridComponents := tfsdk.Attribute{
+		Optional:   true,
+		Attributes: tfsdk.ListNestedAttributes(dashboardLayoutComponentAttributes([]string{"row", "header", "divider"}, &rowComponents)),
+	}
+
+	return tfsdk.Schema{
+		Description: "Manages the `position_json` of a dashboard. Charts on the dashboard that are not part of the layout are kept in rows at the end of the dashboard.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"dashboard_id": {
+				Required:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"tabs": {
+				Optional:    true,
+				Description: "Top level tabs of the dashboard. Conflicts with `components`.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"title": {
+						Required: true,
+						Type:     types.StringType,
+					},
+					"components": gridComponents,
+				}),
+			},
+			"components": gridComponents,
+		},
+	}, nil
+}
+
+func (r resourceDashboardLayoutType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceDashboardLayout{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceDashboardLayout struct {
+	p presetProvider
+}
+
+func (r resourceDashboardLayout) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DashboardLayout
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Tabs != nil && config.Components != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tabs"),
+			"Invalid dashboard layout",
+			"Only one of tabs or components can be set",
+		)
+		return
+	}
+
+	if config.Tabs != nil && len(config.Tabs) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tabs"),
+			"Invalid dashboard layout",
+			"tabs must contain at least one tab",
+		)
+		return
+	}
+
+	for i, tab := range config.Tabs {
+		for j, c := range tab.Components {
+			validateLayoutNode(layoutNodeFromGridComponent(c), path.Root("tabs").AtListIndex(i).AtName("components").AtListIndex(j), &resp.Diagnostics)
+		}
+	}
+
+	for i, c := range config.Components {
+		validateLayoutNode(layoutNodeFromGridComponent(c), path.Root("components").AtListIndex(i), &resp.Diagnostics)
+	}
+}
+
+func (r resourceDashboardLayout) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var layout DashboardLayout
+	diags := req.Plan.Get(ctx, &layout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateDashboardLayout(ctx, r.p.client, layout.DashboardId.Value, &layout)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dashboard layout",
+			"Could not create dashboard layout, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	layout.Id = layout.DashboardId
+
+	diags = resp.State.Set(ctx, layout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardLayout) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardLayout
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1DashboardIdOrSlugWithResponse(ctx, fmt.Sprint(state.DashboardId.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard layout",
+			"Could not read dashboard layout, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard layout",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	position, err := parsePositionJson(res.JSON200.Result.PositionJson)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard layout",
+			"Could not parse position_json, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	result := dashboardLayoutFromPosition(position, &state)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceDashboardLayout) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var layout DashboardLayout
+	diags := req.Plan.Get(ctx, &layout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateDashboardLayout(ctx, r.p.client, layout.DashboardId.Value, &layout)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dashboard layout",
+			"Could not update dashboard layout, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	layout.Id = layout.DashboardId
+
+	diags = resp.State.Set(ctx, layout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete drops the managed layout. Every chart stays on the dashboard and is
+// moved into the rows kept for charts outside of the layout.
+func (r resourceDashboardLayout) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardLayout
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateDashboardLayout(ctx, r.p.client, state.DashboardId.Value, nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dashboard layout",
+			"Could not delete dashboard layout, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// layoutNode is a depth independent view of a layout component, using the
+// position_json type names (ROW, CHART, ...).
+type layoutNode struct {
+	Type       string
+	Key        types.String
+	ChartId    types.Int64
+	Text       types.String
+	Code       types.String
+	Width      types.Int64
+	Height     types.Int64
+	HeaderSize types.String
+	Background types.String
+	Children   []layoutNode
+}
+
+var layoutHeaderSizes = map[string]string{
+	"small":  "SMALL_HEADER",
+	"medium": "MEDIUM_HEADER",
+	"large":  "LARGE_HEADER",
+}
+
+var layoutBackgrounds = map[string]string{
+	"transparent": "BACKGROUND_TRANSPARENT",
+	"white":       "BACKGROUND_WHITE",
+}
+
+func layoutDefaultWidth(componentType string) int64 {
+	switch componentType {
+	case "CHART", "MARKDOWN", "COLUMN":
+		return 4
+	}
+
+	return 0
+}
+
+func layoutDefaultHeight(componentType string) int64 {
+	switch componentType {
+	case "CHART", "MARKDOWN":
+		return 50
+	}
+
+	return 0
+}
+
+// layoutFromDashboardLayout returns the top level nodes: tabs when the layout
+// uses tabs, the grid components otherwise.
+func layoutFromDashboardLayout(layout *DashboardLayout) []layoutNode {
+	var nodes []layoutNode
+
+	if layout == nil {
+		return nodes
+	}
+
+	for _, tab := range layout.Tabs {
+		node := layoutNode{
+			Type: "TAB",
+			Key:  tab.Key,
+			Text: tab.Title,
+		}
+
+		for _, c := range tab.Components {
+			node.Children = append(node.Children, layoutNodeFromGridComponent(c))
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	for _, c := range layout.Components {
+		nodes = append(nodes, layoutNodeFromGridComponent(c))
+	}
+
+	return nodes
+}
+
+func layoutNodeFromGridComponent(c DashboardLayoutGridComponent) layoutNode {
+	node := layoutNode{Type: strings.ToUpper(c.Type.Value), Key: c.Key, ChartId: c.ChartId, Text: c.Text, Code: c.Code, Width: c.Width, Height: c.Height, HeaderSize: c.HeaderSize, Background: c.Background}
+
+	for _, child := range c.Components {
+		node.Children = append(node.Children, layoutNodeFromRowComponent(child))
+	}
+
+	return node
+}
+
+func layoutNodeFromRowComponent(c DashboardLayoutRowComponent) layoutNode {
+	node := layoutNode{Type: strings.ToUpper(c.Type.Value), Key: c.Key, ChartId: c.ChartId, Text: c.Text, Code: c.Code, Width: c.Width, Height: c.Height, HeaderSize: c.HeaderSize, Background: c.Background}
+
+	for _, child := range c.Components {
+		node.Children = append(node.Children, layoutNodeFromComponent(child))
+	}
+
+	return node
+}
+
+func layoutNodeFromComponent(c DashboardLayoutComponent) layoutNode {
+	return layoutNode{Type: strings.ToUpper(c.Type.Value), Key: c.Key, ChartId: c.ChartId, Text: c.Text, Code: c.Code, Width: c.Width, Height: c.Height, HeaderSize: c.HeaderSize, Background: c.Background}
+}
+
+func validateLayoutNode(n layoutNode, p path.Path, diags *diag.Diagnostics) {
+	switch n.Type {
+	case "CHART":
+		if n.ChartId.Null {
+			diags.AddAttributeError(p.AtName("chart_id"), "Invalid dashboard layout", "chart_id is required for chart components")
+		}
+	case "HEADER":
+		if n.Text.Null {
+			diags.AddAttributeError(p.AtName("text"), "Invalid dashboard layout", "text is required for header components")
+		}
+	}
+
+	if n.Children != nil && n.Type != "ROW" && n.Type != "COLUMN" {
+		diags.AddAttributeError(p.AtName("components"), "Invalid dashboard layout", "Only row and column components can contain components")
+	}
+
+	if !n.Width.Null && !n.Width.Unknown && (n.Width.Value < 1 || n.Width.Value > dashboardGridColumnCount) {
+		diags.AddAttributeError(p.AtName("width"), "Invalid dashboard layout", fmt.Sprintf("width must be between 1 and %d", dashboardGridColumnCount))
+	}
+
+	if n.Type == "ROW" {
+		var width int64
+
+		for _, c := range n.Children {
+			if c.Width.Unknown {
+				return
+			}
+
+			if c.Width.Null {
+				width += layoutDefaultWidth(c.Type)
+			} else {
+				width += c.Width.Value
+			}
+		}
+
+		if width > dashboardGridColumnCount {
+			diags.AddAttributeError(p.AtName("components"), "Invalid dashboard layout", fmt.Sprintf("The components of a row cannot be wider than %d columns, got %d", dashboardGridColumnCount, width))
+		}
+	}
+
+	for i, c := range n.Children {
+		validateLayoutNode(c, p.AtName("components").AtListIndex(i), diags)
+	}
+}
+
+// layoutNodeId generates the position_json id of a component. Keys give
+// components an id that survives reordering, charts fall back to their chart
+// id and everything else to its position in the layout.
+func layoutNodeId(n layoutNode, position string) string {
+	if !n.Key.Null {
+		return n.Type + "-" + n.Key.Value
+	}
+
+	return n.Type + "-" + layoutDefaultKey(n, position)
+}
+
+func layoutDefaultKey(n layoutNode, position string) string {
+	if n.Type == "CHART" {
+		return fmt.Sprintf("tf-chart-%d", n.ChartId.Value)
+	}
+
+	return "tf-" + position
+}
+
+func layoutNodeMeta(n layoutNode) map[string]interface{} {
+	width := n.Width.Value
+
+	if n.Width.Null {
+		width = layoutDefaultWidth(n.Type)
+	}
+
+	height := n.Height.Value
+
+	if n.Height.Null {
+		height = layoutDefaultHeight(n.Type)
+	}
+
+	background := layoutBackgrounds["transparent"]
+
+	if !n.Background.Null {
+		background = layoutBackgrounds[n.Background.Value]
+	}
+
+	switch n.Type {
+	case "CHART":
+		return map[string]interface{}{"chartId": n.ChartId.Value, "width": width, "height": height}
+	case "MARKDOWN":
+		return map[string]interface{}{"code": n.Code.Value, "width": width, "height": height}
+	case "COLUMN":
+		return map[string]interface{}{"width": width, "background": background}
+	case "ROW":
+		return map[string]interface{}{"background": background}
+	case "HEADER":
+		headerSize := layoutHeaderSizes["medium"]
+
+		if !n.HeaderSize.Null {
+			headerSize = layoutHeaderSizes[n.HeaderSize.Value]
+		}
+
+		return map[string]interface{}{"text": n.Text.Value, "headerSize": headerSize, "background": background}
+	case "TAB":
+		return map[string]interface{}{"text": n.Text.Value, "defaultText": "Tab title", "placeholder": "Tab title"}
+	}
+
+	return map[string]interface{}{}
+}
+
+func addPositionNode(position map[string]interface{}, n layoutNode, id string, parents []string, nodePosition string) {
+	childParents := append(append([]string{}, parents...), id)
+	children := []string{}
+
+	for i, c := range n.Children {
+		childPosition := nodePosition + "-" + strconv.Itoa(i)
+		childId := layoutNodeId(c, childPosition)
+		children = append(children, childId)
+		addPositionNode(position, c, childId, childParents, childPosition)
+	}
+
+	position[id] = map[string]interface{}{
+		"id":       id,
+		"type":     n.Type,
+		"children": children,
+		"parents":  parents,
+		"meta":     layoutNodeMeta(n),
+	}
+}
+
+func layoutChartIds(nodes []layoutNode) map[int64]struct{} {
+	ids := map[int64]struct{}{}
+
+	for _, n := range nodes {
+		if n.Type == "CHART" {
+			ids[n.ChartId.Value] = struct{}{}
+		}
+
+		for id := range layoutChartIds(n.Children) {
+			ids[id] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+type positionChart struct {
+	Id      string
+	ChartId int64
+	Meta    map[string]interface{}
+}
+
+// buildPositionJson renders the layout into position_json. Charts in the
+// existing position or attached to the dashboard that the layout does not
+// reference are packed into rows after the managed components.
+func buildPositionJson(layout *DashboardLayout, existing map[string]interface{}, dashboardChartIds []int64) map[string]interface{} {
+	nodes := layoutFromDashboardLayout(layout)
+	managedCharts := layoutChartIds(nodes)
+	position := map[string]interface{}{
+		"DASHBOARD_VERSION_KEY": "v2",
+	}
+
+	if header, ok := existing["HEADER_ID"]; ok {
+		position["HEADER_ID"] = header
+	}
+
+	rootChildren := []string{"GRID_ID"}
+	gridChildren := []string{}
+	unmanagedParentId := "GRID_ID"
+	unmanagedParents := []string{"ROOT_ID", "GRID_ID"}
+
+	if layout != nil && len(layout.Tabs) > 0 {
+		tabsId := "TABS-tf"
+		tabIds := []string{}
+
+		for i, n := range nodes {
+			id := layoutNodeId(n, strconv.Itoa(i))
+			tabIds = append(tabIds, id)
+			addPositionNode(position, n, id, []string{"ROOT_ID", tabsId}, strconv.Itoa(i))
+			unmanagedParentId = id
+			unmanagedParents = []string{"ROOT_ID", tabsId, id}
+		}
+
+		rootChildren = []string{tabsId}
+		position[tabsId] = map[string]interface{}{
+			"id":       tabsId,
+			"type":     "TABS",
+			"children": tabIds,
+			"parents":  []string{"ROOT_ID"},
+			"meta":     map[string]interface{}{},
+		}
+	} else {
+		for i, n := range nodes {
+			id := layoutNodeId(n, strconv.Itoa(i))
+			gridChildren = append(gridChildren, id)
+			addPositionNode(position, n, id, []string{"ROOT_ID", "GRID_ID"}, strconv.Itoa(i))
+		}
+	}
+
+	var unmanaged []positionChart
+	seen := map[int64]struct{}{}
+
+	for _, c := range existingPositionCharts(existing) {
+		if _, ok := managedCharts[c.ChartId]; ok {
+			continue
+		}
+
+		if _, ok := seen[c.ChartId]; ok {
+			continue
+		}
+
+		seen[c.ChartId] = struct{}{}
+		unmanaged = append(unmanaged, c)
+	}
+
+	for _, chartId := range dashboardChartIds {
+		if _, ok := managedCharts[chartId]; ok {
+			continue
+		}
+
+		if _, ok := seen[chartId]; ok {
+			continue
+		}
+
+		seen[chartId] = struct{}{}
+		unmanaged = append(unmanaged, positionChart{
+			Id:      fmt.Sprintf("CHART-tf-chart-%d", chartId),
+			ChartId: chartId,
+			Meta:    map[string]interface{}{"chartId": chartId, "width": layoutDefaultWidth("CHART"), "height": layoutDefaultHeight("CHART")},
+		})
+	}
+
+	var unmanagedRows []string
+	var rowChildren []string
+	var rowWidth int64
+
+	flushRow := func() {
+		if len(rowChildren) == 0 {
+			return
+		}
+
+		rowId := fmt.Sprintf("ROW-tf-unmanaged-%d", len(unmanagedRows))
+		unmanagedRows = append(unmanagedRows, rowId)
+		position[rowId] = map[string]interface{}{
+			"id":       rowId,
+			"type":     "ROW",
+			"children": rowChildren,
+			"parents":  unmanagedParents,
+			"meta":     map[string]interface{}{"background": layoutBackgrounds["transparent"]},
+		}
+
+		for _, childId := range rowChildren {
+			position[childId].(map[string]interface{})["parents"] = append(append([]string{}, unmanagedParents...), rowId)
+		}
+
+		rowChildren = nil
+		rowWidth = 0
+	}
+
+	for _, c := range unmanaged {
+		width := layoutDefaultWidth("CHART")
+
+		if w, ok := c.Meta["width"].(float64); ok {
+			width = int64(w)
+		}
+
+		if rowWidth+width > dashboardGridColumnCount {
+			flushRow()
+		}
+
+		position[c.Id] = map[string]interface{}{
+			"id":       c.Id,
+			"type":     "CHART",
+			"children": []string{},
+			"meta":     c.Meta,
+		}
+		rowChildren = append(rowChildren, c.Id)
+		rowWidth += width
+	}
+
+	flushRow()
+
+	if unmanagedParentId == "GRID_ID" {
+		gridChildren = append(gridChildren, unmanagedRows...)
+	} else {
+		tab := position[unmanagedParentId].(map[string]interface{})
+		tab["children"] = append(tab["children"].([]string), unmanagedRows...)
+	}
+
+	position["ROOT_ID"] = map[string]interface{}{
+		"id":       "ROOT_ID",
+		"type":     "ROOT",
+		"children": rootChildren,
+	}
+
+	position["GRID_ID"] = map[string]interface{}{
+		"id":       "GRID_ID",
+		"type":     "GRID",
+		"children": gridChildren,
+		"parents":  []string{"ROOT_ID"},
+	}
+
+	return position
+}
+
+func parsePositionJson(positionJson *string) (map[string]interface{}, error) {
+	position := map[string]interface{}{}
+
+	if positionJson == nil || *positionJson == "" {
+		return position, nil
+	}
+
+	err := json.Unmarshal([]byte(*positionJson), &position)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return position, nil
+}
+
+func positionNode(position map[string]interface{}, id string) map[string]interface{} {
+	node, _ := position[id].(map[string]interface{})
+
+	return node
+}
+
+func positionChildren(node map[string]interface{}) []string {
+	var children []string
+
+	list, _ := node["children"].([]interface{})
+
+	for _, c := range list {
+		if id, ok := c.(string); ok {
+			children = append(children, id)
+		}
+	}
+
+	return children
+}
+
+func positionChartId(node map[string]interface{}) int64 {
+	meta, _ := node["meta"].(map[string]interface{})
+	chartId, _ := meta["chartId"].(float64)
+
+	return int64(chartId)
+}
+
+// existingPositionCharts walks the position from the root so that charts
+// keep their order when they are moved out of the managed layout.
+func existingPositionCharts(position map[string]interface{}) []positionChart {
+	var charts []positionChart
+	visited := map[string]struct{}{}
+
+	var walk func(id string)
+	walk = func(id string) {
+		if _, ok := visited[id]; ok {
+			return
+		}
+
+		visited[id] = struct{}{}
+		node := positionNode(position, id)
+
+		if node == nil {
+			return
+		}
+
+		if node["type"] == "CHART" {
+			meta, _ := node["meta"].(map[string]interface{})
+			charts = append(charts, positionChart{Id: id, ChartId: positionChartId(node), Meta: meta})
+		}
+
+		for _, c := range positionChildren(node) {
+			walk(c)
+		}
+	}
+
+	walk("ROOT_ID")
+
+	return charts
+}
+
+func updateDashboardLayout(ctx context.Context, c *client.ClientWithResponses, dashboardId int64, layout *DashboardLayout) error {
+	res, err := c.GetApiV1DashboardIdOrSlugWithResponse(ctx, fmt.Sprint(dashboardId))
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != 200 {
+		return fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	existing, err := parsePositionJson(res.JSON200.Result.PositionJson)
+
+	if err != nil {
+		return err
+	}
+
+	chartsRes, err := c.GetApiV1DashboardIdOrSlugChartsWithResponse(ctx, fmt.Sprint(dashboardId))
+
+	if err != nil {
+		return err
+	}
+
+	if chartsRes.StatusCode() != 200 {
+		return fmt.Errorf("%v response returned: %v", chartsRes.StatusCode(), string(chartsRes.Body))
+	}
+
+	var chartIds []int64
+
+	if chartsRes.JSON200.Result != nil {
+		for _, chart := range *chartsRes.JSON200.Result {
+			if chart.SliceId != nil {
+				chartIds = append(chartIds, int64(*chart.SliceId))
+			}
+		}
+	}
+
+	position, err := json.Marshal(buildPositionJson(layout, existing, chartIds))
+
+	if err != nil {
+		return err
+	}
+
+	positionJson := string(position)
+	putRes, err := c.PutApiV1DashboardPkWithResponse(ctx, int(dashboardId), client.PutApiV1DashboardPkJSONRequestBody{
+		PositionJson: &positionJson,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if putRes.StatusCode() != 200 {
+		return fmt.Errorf("Unable to update layout: %v response returned: %v", putRes.StatusCode(), string(putRes.Body))
+	}
+
+	return nil
+}
+
+// dashboardLayoutFromPosition rebuilds the layout from position_json so
+// changes made in the dashboard editor show up as drift. Charts that are not
+// part of the prior layout are skipped, and values that match the defaults
+// stay null where the prior layout left them unset.
+func dashboardLayoutFromPosition(position map[string]interface{}, prior *DashboardLayout) *DashboardLayout {
+	priorNodes := layoutFromDashboardLayout(prior)
+	managedCharts := layoutChartIds(priorNodes)
+	result := &DashboardLayout{
+		Id:          prior.Id,
+		DashboardId: prior.DashboardId,
+	}
+
+	rootChildren := positionChildren(positionNode(position, "ROOT_ID"))
+	var tabs map[string]interface{}
+
+	if len(rootChildren) > 0 {
+		if node := positionNode(position, rootChildren[0]); node != nil && node["type"] == "TABS" {
+			tabs = node
+		}
+	}
+
+	if tabs != nil {
+		result.Tabs = []DashboardLayoutTab{}
+
+		for i, tabId := range positionChildren(tabs) {
+			var priorTab *layoutNode
+
+			if i < len(priorNodes) {
+				priorTab = &priorNodes[i]
+			}
+
+			tab := layoutNodeFromPosition(position, tabId, strconv.Itoa(i), priorTab, managedCharts)
+
+			if tab == nil {
+				continue
+			}
+
+			dashboardTab := DashboardLayoutTab{
+				Key:   tab.Key,
+				Title: tab.Text,
+			}
+
+			if tab.Children != nil {
+				dashboardTab.Components = []DashboardLayoutGridComponent{}
+			}
+
+			for _, c := range tab.Children {
+				dashboardTab.Components = append(dashboardTab.Components, gridComponentFromLayoutNode(c))
+			}
+
+			result.Tabs = append(result.Tabs, dashboardTab)
+		}
+
+		return result
+	}
+
+	grid := &layoutNode{Type: "GRID"}
+
+	if prior.Tabs == nil {
+		grid.Children = priorNodes
+	}
+
+	node := layoutNodeFromPosition(position, "GRID_ID", "", grid, managedCharts)
+
+	if node == nil || node.Children == nil {
+		return result
+	}
+
+	result.Components = []DashboardLayoutGridComponent{}
+
+	for _, c := range node.Children {
+		result.Components = append(result.Components, gridComponentFromLayoutNode(c))
+	}
+
+	return result
+}
+
+func layoutNodeFromPosition(position map[string]interface{}, id string, nodePosition string, prior *layoutNode, managedCharts map[int64]struct{}) *layoutNode {
+	node := positionNode(position, id)
+
+	if node == nil || strings.HasPrefix(id, "ROW-tf-unmanaged-") {
+		return nil
+	}
+
+	componentType, _ := node["type"].(string)
+	meta, _ := node["meta"].(map[string]interface{})
+
+	if prior != nil && prior.Type != componentType {
+		prior = nil
+	}
+
+	n := &layoutNode{
+		Type:       componentType,
+		Key:        types.String{Null: true},
+		ChartId:    types.Int64{Null: true},
+		Text:       types.String{Null: true},
+		Code:       types.String{Null: true},
+		Width:      types.Int64{Null: true},
+		Height:     types.Int64{Null: true},
+		HeaderSize: types.String{Null: true},
+		Background: types.String{Null: true},
+	}
+
+	switch componentType {
+	case "CHART":
+		chartId := positionChartId(node)
+
+		if _, ok := managedCharts[chartId]; !ok {
+			return nil
+		}
+
+		n.ChartId = types.Int64{Value: chartId}
+		n.Width = layoutInt64FromMeta(meta, "width", layoutDefaultWidth(componentType), priorField(prior, func(p *layoutNode) types.Int64 { return p.Width }))
+		n.Height = layoutInt64FromMeta(meta, "height", layoutDefaultHeight(componentType), priorField(prior, func(p *layoutNode) types.Int64 { return p.Height }))
+	case "MARKDOWN":
+		n.Code = layoutStringFromMeta(meta, "code", nil, "", priorField(prior, func(p *layoutNode) types.String { return p.Code }))
+		n.Width = layoutInt64FromMeta(meta, "width", layoutDefaultWidth(componentType), priorField(prior, func(p *layoutNode) types.Int64 { return p.Width }))
+		n.Height = layoutInt64FromMeta(meta, "height", layoutDefaultHeight(componentType), priorField(prior, func(p *layoutNode) types.Int64 { return p.Height }))
+	case "COLUMN":
+		n.Width = layoutInt64FromMeta(meta, "width", layoutDefaultWidth(componentType), priorField(prior, func(p *layoutNode) types.Int64 { return p.Width }))
+		n.Background = layoutStringFromMeta(meta, "background", layoutBackgrounds, "transparent", priorField(prior, func(p *layoutNode) types.String { return p.Background }))
+	case "ROW":
+		n.Background = layoutStringFromMeta(meta, "background", layoutBackgrounds, "transparent", priorField(prior, func(p *layoutNode) types.String { return p.Background }))
+	case "HEADER":
+		text, _ := meta["text"].(string)
+		n.Text = types.String{Value: text}
+		n.HeaderSize = layoutStringFromMeta(meta, "headerSize", layoutHeaderSizes, "medium", priorField(prior, func(p *layoutNode) types.String { return p.HeaderSize }))
+		n.Background = layoutStringFromMeta(meta, "background", layoutBackgrounds, "transparent", priorField(prior, func(p *layoutNode) types.String { return p.Background }))
+	case "TAB":
+		text, _ := meta["text"].(string)
+		n.Text = types.String{Value: text}
+	}
+
+	if componentType != "GRID" {
+		n.Key = types.String{Value: strings.TrimPrefix(id, componentType+"-")}
+
+		if id == componentType+"-"+layoutDefaultKey(*n, nodePosition) && (prior == nil || prior.Key.Null) {
+			n.Key = types.String{Null: true}
+		}
+	}
+
+	children := positionChildren(node)
+
+	if children != nil || (prior != nil && prior.Children != nil) {
+		n.Children = []layoutNode{}
+	}
+
+	for _, childId := range children {
+		childPosition := strconv.Itoa(len(n.Children))
+
+		if nodePosition != "" {
+			childPosition = nodePosition + "-" + childPosition
+		}
+
+		var priorChild *layoutNode
+
+		if prior != nil && len(n.Children) < len(prior.Children) {
+			priorChild = &prior.Children[len(n.Children)]
+		}
+
+		child := layoutNodeFromPosition(position, childId, childPosition, priorChild, managedCharts)
+
+		if child != nil {
+			n.Children = append(n.Children, *child)
+		}
+	}
+
+	// containers left holding only unmanaged charts are not part of the layout
+	if len(children) > 0 && len(n.Children) == 0 && (componentType == "ROW" || componentType == "COLUMN") {
+		return nil
+	}
+
+	if len(n.Children) == 0 && (prior == nil || prior.Children == nil) {
+		n.Children = nil
+	}
+
+	return n
+}
+
+func priorField[T any](prior *layoutNode, field func(p *layoutNode) T) *T {
+	if prior == nil {
+		return nil
+	}
+
+	value := field(prior)
+
+	return &value
+}
+
+func layoutInt64FromMeta(meta map[string]interface{}, key string, defaultValue int64, prior *types.Int64) types.Int64 {
+	value, ok := meta[key].(float64)
+
+	if !ok {
+		return types.Int64{Null: true}
+	}
+
+	if int64(value) == defaultValue && (prior == nil || prior.Null) {
+		return types.Int64{Null: true}
+	}
+
+	return types.Int64{Value: int64(value)}
+}
+
+// layoutStringFromMeta maps a meta value back to its attribute value through
+// values, which maps attribute values to position_json values.
+func layoutStringFromMeta(meta map[string]interface{}, key string, values map[string]string, defaultValue string, prior *types.String) types.String {
+	raw, ok := meta[key].(string)
+
+	if !ok {
+		return types.String{Null: true}
+	}
+
+	value := raw
+
+	for k, v := range values {
+		if v == raw {
+			value = k
+		}
+	}
+
+	if value == defaultValue && (prior == nil || prior.Null) {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: value}
+}
+
+func gridComponentFromLayoutNode(n layoutNode) DashboardLayoutGridComponent {
+	c := DashboardLayoutGridComponent{Type: types.String{Value: strings.ToLower(n.Type)}, Key: n.Key, ChartId: n.ChartId, Text: n.Text, Code: n.Code, Width: n.Width, Height: n.Height, HeaderSize: n.HeaderSize, Background: n.Background}
+
+	if n.Children != nil {
+		c.Components = []DashboardLayoutRowComponent{}
+	}
+
+	for _, child := range n.Children {
+		c.Components = append(c.Components, rowComponentFromLayoutNode(child))
+	}
+
+	return c
+}
+
+func rowComponentFromLayoutNode(n layoutNode) DashboardLayoutRowComponent {
+	c := DashboardLayoutRowComponent{Type: types.String{Value: strings.ToLower(n.Type)}, Key: n.Key, ChartId: n.ChartId, Text: n.Text, Code: n.Code, Width: n.Width, Height: n.Height, HeaderSize: n.HeaderSize, Background: n.Background}
+
+	if n.Children != nil {
+		c.Components = []DashboardLayoutComponent{}
+	}
+
+	for _, child := range n.Children {
+		c.Components = append(c.Components, componentFromLayoutNode(child))
+	}
+
+	return c
+}
+
+func componentFromLayoutNode(n layoutNode) DashboardLayoutComponent {
+	return DashboardLayoutComponent{Type: types.String{Value: strings.ToLower(n.Type)}, Key: n.Key, ChartId: n.ChartId, Text: n.Text, Code: n.Code, Width: n.Width, Height: n.Height, HeaderSize: n.HeaderSize, Background: n.Background}
+}
@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"mime/multipart"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -21,11 +24,22 @@ type DatasetColumn struct {
 }
 
 type Dataset struct {
-	Id         types.Int64     `tfsdk:"id"`
-	Title      types.String    `tfsdk:"title"`
-	Sql        types.String    `tfsdk:"sql"`
-	DatabaseId types.Int64     `tfsdk:"database_id"`
-	Columns    []DatasetColumn `tfsdk:"columns"`
+	Id         types.Int64  `tfsdk:"id"`
+	Title      types.String `tfsdk:"title"`
+	Sql        types.String `tfsdk:"sql"`
+	DatabaseId types.Int64  `tfsdk:"database_id"`
+	Columns    types.List   `tfsdk:"columns"`
+	OwnerIds   types.Set    `tfsdk:"owner_ids"`
+
+	TemplateParams types.String `tfsdk:"template_params"`
+	DeletionPolicy types.String `tfsdk:"deletion_policy"`
+}
+
+var datasetColumnType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	},
 }
 
 type resourceDatasetType struct{}
@@ -51,7 +65,9 @@ func (r resourceDatasetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Type:     types.StringType,
 			},
 			"columns": {
-				Required: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "The columns returned by `sql`. When unset, they are discovered from the database after the dataset is created or its query changes. When set, a warning lists any mismatch with the actual result.",
 				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
 					"name": {
 						Required: true,
@@ -63,6 +79,25 @@ func (r resourceDatasetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					},
 				}),
 			},
+			"owner_ids": {
+				Optional:    true,
+				Type:        types.SetType{ElemType: types.Int64Type},
+				Description: "Ids of the users that own the dataset. See the `preset_user` data source.",
+			},
+			"template_params": {
+				Optional:      true,
+				Type:          jsonStringType{},
+				Description:   "A JSON object of parameters for the Jinja templates in `sql`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{jsonSemanticEquality()},
+			},
+			"deletion_policy": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "What to do when the dataset is destroyed while charts not managed by Terraform still use it: `block` (default) refuses, `warn` deletes it anyway and `cascade` deletes those charts too.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("block", "warn", "cascade"),
+				},
+			},
 		},
 	}, nil
 }
@@ -88,6 +123,87 @@ type sqllabVizData struct {
 	} `json:"columns"`
 }
 
+// ModifyPlan keeps discovered columns until the query changes, and validates
+// the dataset SQL when validate_dataset_sql is set on the provider. This needs
+// the API, so it cannot run in ValidateConfig.
+func (r resourceDataset) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Dataset
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queryChanged := true
+
+	if !req.State.Raw.IsNull() {
+		var state Dataset
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		queryChanged = !state.DatabaseId.Equal(plan.DatabaseId) || !state.Sql.Equal(plan.Sql) || !state.TemplateParams.Equal(plan.TemplateParams)
+
+		var configColumns types.List
+		diags = req.Config.GetAttribute(ctx, path.Root("columns"), &configColumns)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if configColumns.Null && !queryChanged {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("columns"), state.Columns)...)
+		}
+	}
+
+	if r.p.client == nil || !r.p.validateDatasetSql || !queryChanged {
+		return
+	}
+
+	if plan.DatabaseId.Unknown || plan.Sql.Unknown || plan.TemplateParams.Unknown {
+		return
+	}
+
+	schema, err := getDatabaseDefaultSchema(ctx, r.p.client, plan.DatabaseId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("sql"),
+			"Could not validate SQL",
+			fmt.Sprintf("Could not read database %d schemas, unexpected error: %s", plan.DatabaseId.Value, err),
+		)
+
+		return
+	}
+
+	var templateParams *map[string]interface{}
+
+	if !plan.TemplateParams.Null {
+		params := map[string]interface{}{}
+		err = json.Unmarshal([]byte(plan.TemplateParams.Value), &params)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template_params"),
+				"Invalid template params",
+				"template_params must be a JSON object: "+err.Error(),
+			)
+
+			return
+		}
+
+		templateParams = &params
+	}
+
+	resp.Diagnostics.Append(validateSql(ctx, r.p.client, plan.DatabaseId.Value, &schema, templateParams, plan.Sql.Value, path.Root("sql"))...)
+}
+
 func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var dataset Dataset
 	diags := req.Plan.Get(ctx, &dataset)
@@ -97,12 +213,7 @@ func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	force := false
-	resSchemas, err := r.p.client.GetApiV1DatabasePkSchemasWithResponse(ctx, int(dataset.DatabaseId.Value), &client.GetApiV1DatabasePkSchemasParams{
-		Q: &client.DatabaseSchemasQuerySchema{
-			Force: &force,
-		},
-	})
+	schema, err := getDatabaseDefaultSchema(ctx, r.p.client, dataset.DatabaseId.Value)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -115,21 +226,22 @@ func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if resSchemas.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Error reading database schemas",
-			fmt.Sprintf("%v response returned: %v", resSchemas.StatusCode(), string(resSchemas.Body)),
-		)
-
-		return
-	}
-
 	var columns []struct {
 		Name string `json:"name"`
 		Type string `json:"type"`
 	}
 
-	for _, column := range dataset.Columns {
+	var configuredColumns []DatasetColumn
+
+	if !dataset.Columns.Unknown && !dataset.Columns.Null {
+		diags = dataset.Columns.ElementsAs(ctx, &configuredColumns, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, column := range configuredColumns {
 		columns = append(columns, struct {
 			Name string `json:"name"`
 			Type string `json:"type"`
@@ -142,7 +254,7 @@ func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest,
 	var formData bytes.Buffer
 	formDataWriter := multipart.NewWriter(&formData)
 	data := &sqllabVizData{
-		Schema:         (*resSchemas.JSON200.Result)[0],
+		Schema:         schema,
 		Sql:            dataset.Sql.Value,
 		DbId:           int(dataset.DatabaseId.Value),
 		DatasourceName: dataset.Title.Value,
@@ -192,9 +304,17 @@ func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	owners, diags := int32SlicePointer(ctx, dataset.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	isManagedExternally := true
 	putRes, err := r.p.client.PutApiV1DatasetPkWithResponse(ctx, int(*res.JSON200.Data.Id), &client.PutApiV1DatasetPkParams{}, client.PutApiV1DatasetPkJSONRequestBody{
 		IsManagedExternally: &isManagedExternally,
+		Owners:              owners,
+		TemplateParams:      stringPointer(dataset.TemplateParams),
 	})
 
 	if err != nil {
@@ -221,8 +341,14 @@ func (r resourceDataset) Create(ctx context.Context, req resource.CreateRequest,
 		Sql:        types.String{Value: *res.JSON200.Data.Sql},
 		DatabaseId: dataset.DatabaseId,
 		Columns:    dataset.Columns,
+		OwnerIds:   dataset.OwnerIds,
+
+		TemplateParams: dataset.TemplateParams,
+		DeletionPolicy: dataset.DeletionPolicy,
 	}
 
+	resp.Diagnostics.Append(r.refreshColumns(ctx, result, configuredColumns)...)
+
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -264,8 +390,21 @@ func (r resourceDataset) Read(ctx context.Context, req resource.ReadRequest, res
 		Sql:        types.String{Value: *res.JSON200.Result.Sql},
 		DatabaseId: dataset.DatabaseId,
 		Columns:    dataset.Columns,
+
+		TemplateParams: optionalJsonStringFromPointer(res.JSON200.Result.TemplateParams, dataset.TemplateParams),
+		DeletionPolicy: dataset.DeletionPolicy,
 	}
 
+	var ownerIds []int64
+
+	if res.JSON200.Result.Owners != nil {
+		for _, owner := range *res.JSON200.Result.Owners {
+			ownerIds = append(ownerIds, int64(*owner.Id))
+		}
+	}
+
+	result.OwnerIds = int64SetFromIds(ownerIds, dataset.OwnerIds)
+
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -288,10 +427,18 @@ func (r resourceDataset) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	owners, diags := int32SlicePointer(ctx, dataset.OwnerIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	isManagedExternally := true
 	res, err := r.p.client.PutApiV1DatasetPkWithResponse(ctx, int(state.Id.Value), &client.PutApiV1DatasetPkParams{}, client.PutApiV1DatasetPkJSONRequestBody{
 		Sql:                 &dataset.Sql.Value,
 		IsManagedExternally: &isManagedExternally,
+		Owners:              owners,
+		TemplateParams:      emptyStringPointer(dataset.TemplateParams),
 	})
 
 	if err != nil {
@@ -318,6 +465,24 @@ func (r resourceDataset) Update(ctx context.Context, req resource.UpdateRequest,
 		Sql:        types.String{Value: *res.JSON200.Result.Sql},
 		DatabaseId: dataset.DatabaseId,
 		Columns:    dataset.Columns,
+		OwnerIds:   dataset.OwnerIds,
+
+		TemplateParams: dataset.TemplateParams,
+		DeletionPolicy: dataset.DeletionPolicy,
+	}
+
+	if dataset.Columns.Unknown || !state.Sql.Equal(dataset.Sql) || !state.TemplateParams.Equal(dataset.TemplateParams) {
+		var configuredColumns []DatasetColumn
+
+		if !dataset.Columns.Unknown && !dataset.Columns.Null {
+			diags = dataset.Columns.ElementsAs(ctx, &configuredColumns, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		resp.Diagnostics.Append(r.refreshColumns(ctx, result, configuredColumns)...)
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -335,6 +500,22 @@ func (r resourceDataset) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	related, err := getDatasetRelatedObjects(ctx, r.p.client, state.Id.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dataset",
+			"Could not read the charts and dashboards using the dataset, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(applyDeletionPolicy(ctx, r.p.client, state.DeletionPolicy, "dataset", related)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.p.client.DeleteApiV1DatasetPkWithResponse(ctx, int(state.Id.Value))
 
 	if err != nil {
@@ -342,6 +523,8 @@ func (r resourceDataset) Delete(ctx context.Context, req resource.DeleteRequest,
 			"Error deleting dataset",
 			"Could not delete dataset, unexpected error: "+err.Error(),
 		)
+
+		return
 	}
 
 	if res.StatusCode() != 200 {
@@ -353,3 +536,196 @@ func (r resourceDataset) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// getDatasetRelatedObjects returns the charts built on a dataset and the
+// dashboards showing them.
+func getDatasetRelatedObjects(ctx context.Context, c *client.ClientWithResponses, datasetId int64) (relatedObjects, error) {
+	var related relatedObjects
+
+	res, err := c.GetApiV1DatasetPkRelatedObjectsWithResponse(ctx, int(datasetId))
+
+	if err != nil {
+		return related, err
+	}
+
+	if res.StatusCode() != 200 {
+		return related, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	if res.JSON200.Charts != nil && res.JSON200.Charts.Result != nil {
+		for _, chart := range *res.JSON200.Charts.Result {
+			if chart.Id != nil {
+				related.Charts = append(related.Charts, newRelatedObject(*chart.Id, chart.SliceName))
+			}
+		}
+	}
+
+	if res.JSON200.Dashboards != nil && res.JSON200.Dashboards.Result != nil {
+		for _, dashboard := range *res.JSON200.Dashboards.Result {
+			if dashboard.Id != nil {
+				related.Dashboards = append(related.Dashboards, newRelatedObject(*dashboard.Id, dashboard.Title))
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// getDatabaseDefaultSchema returns the first schema of a database, which is
+// the schema datasets are created in.
+func getDatabaseDefaultSchema(ctx context.Context, c *client.ClientWithResponses, databaseId int64) (string, error) {
+	force := false
+	res, err := c.GetApiV1DatabasePkSchemasWithResponse(ctx, int(databaseId), &client.GetApiV1DatabasePkSchemasParams{
+		Q: &client.DatabaseSchemasQuerySchema{
+			Force: &force,
+		},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode() != 200 {
+		return "", fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	if res.JSON200.Result == nil || len(*res.JSON200.Result) == 0 {
+		return "", fmt.Errorf("database %d has no schemas", databaseId)
+	}
+
+	return (*res.JSON200.Result)[0], nil
+}
+
+// refreshColumns syncs the dataset columns with the result of its query. When
+// columns are not configured, the discovered columns are stored in result,
+// otherwise mismatches with the configured columns are reported as warnings.
+func (r resourceDataset) refreshColumns(ctx context.Context, result *Dataset, configured []DatasetColumn) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	actual, err := refreshDatasetColumns(ctx, r.p.client, int(result.Id.Value))
+
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("columns"),
+			"Could not discover dataset columns",
+			"Could not refresh dataset columns, unexpected error: "+err.Error(),
+		)
+
+		if result.Columns.Unknown {
+			result.Columns = datasetColumnsValue([]DatasetColumn{})
+		}
+
+		return diags
+	}
+
+	if result.Columns.Unknown {
+		result.Columns = datasetColumnsValue(actual)
+
+		return diags
+	}
+
+	if mismatches := datasetColumnMismatches(configured, actual); len(mismatches) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("columns"),
+			"Dataset columns do not match the query result",
+			strings.Join(mismatches, "\n"),
+		)
+	}
+
+	return diags
+}
+
+// refreshDatasetColumns syncs the dataset with the result of its SQL and
+// returns the resulting columns.
+func refreshDatasetColumns(ctx context.Context, c *client.ClientWithResponses, datasetId int) ([]DatasetColumn, error) {
+	refreshRes, err := c.PutApiV1DatasetPkRefreshWithResponse(ctx, datasetId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshRes.StatusCode() != 200 {
+		return nil, fmt.Errorf("%v response returned: %v", refreshRes.StatusCode(), string(refreshRes.Body))
+	}
+
+	res, err := c.GetApiV1DatasetPkWithResponse(ctx, datasetId, &client.GetApiV1DatasetPkParams{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != 200 {
+		return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	columns := []DatasetColumn{}
+
+	for _, column := range res.JSON200.Result.Columns {
+		columnType := ""
+
+		if column.Type != nil {
+			columnType = *column.Type
+		}
+
+		columns = append(columns, DatasetColumn{
+			Name: types.String{Value: column.ColumnName},
+			Type: types.String{Value: columnType},
+		})
+	}
+
+	return columns, nil
+}
+
+func datasetColumnsValue(columns []DatasetColumn) types.List {
+	elems := []attr.Value{}
+
+	for _, column := range columns {
+		elems = append(elems, types.Object{
+			AttrTypes: datasetColumnType.AttrTypes,
+			Attrs: map[string]attr.Value{
+				"name": column.Name,
+				"type": column.Type,
+			},
+		})
+	}
+
+	return types.List{ElemType: datasetColumnType, Elems: elems}
+}
+
+// datasetColumnMismatches describes the differences between the configured
+// columns and the actual result set. Types are compared case insensitively,
+// because engines report them in different cases.
+func datasetColumnMismatches(configured []DatasetColumn, actual []DatasetColumn) []string {
+	var mismatches []string
+
+	actualTypes := map[string]string{}
+
+	for _, column := range actual {
+		actualTypes[column.Name.Value] = column.Type.Value
+	}
+
+	configuredNames := map[string]bool{}
+
+	for _, column := range configured {
+		configuredNames[column.Name.Value] = true
+		actualType, ok := actualTypes[column.Name.Value]
+
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("column %s is not returned by the query", column.Name.Value))
+
+			continue
+		}
+
+		if actualType != "" && !strings.EqualFold(actualType, column.Type.Value) {
+			mismatches = append(mismatches, fmt.Sprintf("column %s has type %s, not %s", column.Name.Value, actualType, column.Type.Value))
+		}
+	}
+
+	for _, column := range actual {
+		if !configuredNames[column.Name.Value] {
+			mismatches = append(mismatches, fmt.Sprintf("column %s is returned by the query but not configured", column.Name.Value))
+		}
+	}
+
+	return mismatches
+}
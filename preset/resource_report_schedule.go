@@ -0,0 +1,799 @@
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type ReportScheduleRecipient struct {
+	Type   types.String `tfsdk:"type"`
+	Target types.String `tfsdk:"target"`
+}
+
+type ReportScheduleValidatorConfig struct {
+	Op        types.String  `tfsdk:"op"`
+	Threshold types.Float64 `tfsdk:"threshold"`
+}
+
+type ReportSchedule struct {
+	Id              types.Int64                    `tfsdk:"id"`
+	Name            types.String                   `tfsdk:"name"`
+	Description     types.String                   `tfsdk:"description"`
+	Type            types.String                   `tfsdk:"type"`
+	ChartId         types.Int64                    `tfsdk:"chart_id"`
+	DashboardId     types.Int64                    `tfsdk:"dashboard_id"`
+	Crontab         types.String                   `tfsdk:"crontab"`
+	Timezone        types.String                   `tfsdk:"timezone"`
+	ReportFormat    types.String                   `tfsdk:"report_format"`
+	Recipients      []ReportScheduleRecipient      `tfsdk:"recipients"`
+	Active          types.Bool                     `tfsdk:"active"`
+	GracePeriod     types.Int64                    `tfsdk:"grace_period"`
+	WorkingTimeout  types.Int64                    `tfsdk:"working_timeout"`
+	LogRetention    types.Int64                    `tfsdk:"log_retention"`
+	ForceScreenshot types.Bool                     `tfsdk:"force_screenshot"`
+	SelectedTabs    types.List                     `tfsdk:"selected_tabs"`
+	DatabaseId      types.Int64                    `tfsdk:"database_id"`
+	Sql             types.String                   `tfsdk:"sql"`
+	ValidatorType   types.String                   `tfsdk:"validator_type"`
+	ValidatorConfig *ReportScheduleValidatorConfig `tfsdk:"validator_config"`
+}
+
+type resourceReportScheduleType struct{}
+
+func (r resourceReportScheduleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Schedules a report or alert that is sent by email or Slack. Exactly one of `chart_id` or `dashboard_id` must be set. Alerts also need `database_id`, `sql` and `validator_type`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"description": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"type": {
+				Required: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"Report",
+						"Alert",
+					),
+				},
+			},
+			"chart_id": {
+				Optional: true,
+				Type:     types.Int64Type,
+			},
+			"dashboard_id": {
+				Optional: true,
+				Type:     types.Int64Type,
+			},
+			"crontab": {
+				Required:    true,
+				Type:        types.StringType,
+				Description: "When the schedule runs, as a five field crontab expression.",
+				Validators: []tfsdk.AttributeValidator{
+					crontab(),
+				},
+			},
+			"timezone": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.StringType,
+				Description:   "The timezone the crontab is evaluated in. Defaults to `UTC`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+				Validators: []tfsdk.AttributeValidator{
+					timezone(),
+				},
+			},
+			"report_format": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"PNG",
+						"CSV",
+						"TEXT",
+					),
+				},
+			},
+			"recipients": {
+				Required: true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"type": {
+						Required: true,
+						Type:     types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf(
+								"Email",
+								"Slack",
+							),
+						},
+					},
+					"target": {
+						Required:    true,
+						Type:        types.StringType,
+						Description: "Comma separated email addresses, or Slack channel names.",
+					},
+				}),
+			},
+			"active": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"grace_period": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.Int64Type,
+				Description:   "Seconds to wait before an alert is sent again.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"working_timeout": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.Int64Type,
+				Description:   "Seconds a run may take before it is marked as failed.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"log_retention": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.Int64Type,
+				Description:   "Days that execution logs are kept.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"force_screenshot": {
+				Optional:      true,
+				Computed:      true,
+				Type:          types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"selected_tabs": {
+				Optional:      true,
+				Type:          types.ListType{ElemType: types.Int64Type},
+				Description:   "Dashboard tabs included in the report. The API only accepts tabs on create, so changing them replaces the schedule.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"database_id": {
+				Optional:    true,
+				Type:        types.Int64Type,
+				Description: "The database the alert query runs against.",
+			},
+			"sql": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "The alert query. It is validated at plan time when the database engine supports it.",
+			},
+			"validator_type": {
+				Optional:    true,
+				Type:        types.StringType,
+				Description: "`not null` triggers when the query returns a value that is not null, empty or 0. `operator` compares the value with `validator_config`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"not null",
+						"operator",
+					),
+				},
+			},
+			"validator_config": {
+				Optional: true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"op": {
+						Required: true,
+						Type:     types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf(
+								"<",
+								"<=",
+								">",
+								">=",
+								"==",
+								"!=",
+							),
+						},
+					},
+					"threshold": {
+						Required: true,
+						Type:     types.Float64Type,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r resourceReportScheduleType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceReportSchedule{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceReportSchedule struct {
+	p presetProvider
+}
+
+func (r resourceReportSchedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ReportSchedule
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ChartId.Unknown || config.DashboardId.Unknown {
+		return
+	}
+
+	if config.ChartId.Null == config.DashboardId.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dashboard_id"),
+			"Invalid report schedule",
+			"Exactly one of chart_id or dashboard_id must be set",
+		)
+	}
+
+	if !config.SelectedTabs.Null && config.DashboardId.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("selected_tabs"),
+			"Invalid report schedule",
+			"selected_tabs can only be set on dashboard reports",
+		)
+	}
+
+	if config.Type.Unknown {
+		return
+	}
+
+	if config.Type.Value == "Alert" {
+		if config.DatabaseId.Null || config.Sql.Null || config.ValidatorType.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sql"),
+				"Invalid alert",
+				"Alerts require database_id, sql and validator_type",
+			)
+		}
+
+		if config.ValidatorType.Value == "operator" && config.ValidatorConfig == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validator_config"),
+				"Invalid alert",
+				"validator_config must be set when validator_type is operator",
+			)
+		}
+
+		if config.ValidatorType.Value == "not null" && config.ValidatorConfig != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validator_config"),
+				"Invalid alert",
+				"validator_config can only be set when validator_type is operator",
+			)
+		}
+
+		return
+	}
+
+	if !config.DatabaseId.Null || !config.Sql.Null || !config.ValidatorType.Null || config.ValidatorConfig != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid report schedule",
+			"database_id, sql, validator_type and validator_config can only be set on alerts",
+		)
+	}
+}
+
+// ModifyPlan validates the alert query. This needs the API, so it cannot run
+// in ValidateConfig.
+func (r resourceReportSchedule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.p.client == nil {
+		return
+	}
+
+	var plan ReportSchedule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DatabaseId.Null || plan.DatabaseId.Unknown || plan.Sql.Null || plan.Sql.Unknown {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSql(ctx, r.p.client, plan.DatabaseId.Value, nil, nil, plan.Sql.Value, path.Root("sql"))...)
+}
+
+func (r resourceReportSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var schedule ReportSchedule
+	diags := req.Plan.Get(ctx, &schedule)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.PostApiV1ReportJSONRequestBody{
+		Name:                schedule.Name.Value,
+		Description:         stringPointer(schedule.Description),
+		Type:                client.ReportScheduleRestApiPostType(schedule.Type.Value),
+		Chart:               int32Pointer(schedule.ChartId),
+		Dashboard:           int32Pointer(schedule.DashboardId),
+		Crontab:             schedule.Crontab.Value,
+		Recipients:          reportScheduleRecipients(schedule.Recipients),
+		Active:              boolPointer(schedule.Active),
+		GracePeriod:         int32Pointer(schedule.GracePeriod),
+		WorkingTimeout:      int32Pointer(schedule.WorkingTimeout),
+		LogRetention:        int32Pointer(schedule.LogRetention),
+		ForceScreenshot:     boolPointer(schedule.ForceScreenshot),
+		Database:            int32Pointer(schedule.DatabaseId),
+		Sql:                 stringPointer(schedule.Sql),
+		ValidatorConfigJson: reportScheduleValidatorConfig(schedule.ValidatorConfig),
+	}
+
+	if !schedule.ValidatorType.Null {
+		validatorType := client.ReportScheduleRestApiPostValidatorType(schedule.ValidatorType.Value)
+		body.ValidatorType = &validatorType
+	}
+
+	if !schedule.Timezone.Null && !schedule.Timezone.Unknown {
+		timezone := client.ReportScheduleRestApiPostTimezone(schedule.Timezone.Value)
+		body.Timezone = &timezone
+	}
+
+	if !schedule.ReportFormat.Null && !schedule.ReportFormat.Unknown {
+		reportFormat := client.ReportScheduleRestApiPostReportFormat(schedule.ReportFormat.Value)
+		body.ReportFormat = &reportFormat
+	}
+
+	if !schedule.SelectedTabs.Null {
+		var tabs []int64
+		diags = schedule.SelectedTabs.ElementsAs(ctx, &tabs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		selectedTabs := []int32{}
+
+		for _, tab := range tabs {
+			selectedTabs = append(selectedTabs, int32(tab))
+		}
+
+		body.SelectedTabs = &selectedTabs
+	}
+
+	res, err := r.p.client.PostApiV1ReportWithResponse(ctx, body)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating report schedule",
+			"Could not create report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating report schedule",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	schedule.Id = types.Int64{Value: int64(*res.JSON201.Id)}
+
+	// the create response echoes the request, so read the schedule back to
+	// fill in the defaults chosen by Superset
+	report, err := getReportSchedule(ctx, r.p.client, int(schedule.Id.Value))
+
+	if err == nil && report == nil {
+		err = fmt.Errorf("report schedule %d was not found after create", schedule.Id.Value)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating report schedule",
+			"Could not read report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	result, diags := reportScheduleFromResponse(schedule, report)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceReportSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReportSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := getReportSchedule(ctx, r.p.client, int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading report schedule",
+			"Could not read report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if report == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	result, diags := reportScheduleFromResponse(state, report)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceReportSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var schedule ReportSchedule
+	diags := req.Plan.Get(ctx, &schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ReportSchedule
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportType := client.ReportScheduleRestApiPutType(schedule.Type.Value)
+	body := client.PutApiV1ReportPkJSONRequestBody{
+		Name:                &schedule.Name.Value,
+		Description:         emptyStringPointer(schedule.Description),
+		Type:                &reportType,
+		Chart:               int32Pointer(schedule.ChartId),
+		Dashboard:           int32Pointer(schedule.DashboardId),
+		Crontab:             &schedule.Crontab.Value,
+		Recipients:          reportScheduleRecipients(schedule.Recipients),
+		Active:              boolPointer(schedule.Active),
+		GracePeriod:         int32Pointer(schedule.GracePeriod),
+		WorkingTimeout:      int32Pointer(schedule.WorkingTimeout),
+		LogRetention:        int32Pointer(schedule.LogRetention),
+		ForceScreenshot:     boolPointer(schedule.ForceScreenshot),
+		Database:            int32Pointer(schedule.DatabaseId),
+		Sql:                 stringPointer(schedule.Sql),
+		ValidatorConfigJson: reportScheduleValidatorConfig(schedule.ValidatorConfig),
+	}
+
+	if !schedule.ValidatorType.Null {
+		validatorType := client.ReportScheduleRestApiPutValidatorType(schedule.ValidatorType.Value)
+		body.ValidatorType = &validatorType
+	}
+
+	if !schedule.Timezone.Null && !schedule.Timezone.Unknown {
+		timezone := client.ReportScheduleRestApiPutTimezone(schedule.Timezone.Value)
+		body.Timezone = &timezone
+	}
+
+	if !schedule.ReportFormat.Null && !schedule.ReportFormat.Unknown {
+		reportFormat := client.ReportScheduleRestApiPutReportFormat(schedule.ReportFormat.Value)
+		body.ReportFormat = &reportFormat
+	}
+
+	res, err := r.p.client.PutApiV1ReportPkWithResponse(ctx, int(state.Id.Value), body)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating report schedule",
+			"Could not update report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating report schedule",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	schedule.Id = state.Id
+
+	report, err := getReportSchedule(ctx, r.p.client, int(schedule.Id.Value))
+
+	if err == nil && report == nil {
+		err = fmt.Errorf("report schedule %d was not found after update", schedule.Id.Value)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating report schedule",
+			"Could not read report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	result, diags := reportScheduleFromResponse(schedule, report)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceReportSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReportSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1ReportPkWithResponse(ctx, int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting report schedule",
+			"Could not delete report schedule, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting report schedule",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts the report schedule id.
+func (r resourceReportSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing report schedule",
+			"Could not parse report schedule id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// getReportSchedule returns nil when the schedule no longer exists.
+func getReportSchedule(ctx context.Context, c *client.ClientWithResponses, id int) (*client.ReportScheduleRestApiGet, error) {
+	res, err := c.GetApiV1ReportPkWithResponse(ctx, id, &client.GetApiV1ReportPkParams{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() == 404 {
+		return nil, nil
+	}
+
+	if res.StatusCode() != 200 {
+		return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+	}
+
+	return res.JSON200.Result, nil
+}
+
+func reportScheduleValidatorConfig(config *ReportScheduleValidatorConfig) *client.ValidatorConfigJSON {
+	if config == nil {
+		return nil
+	}
+
+	threshold := float32(config.Threshold.Value)
+
+	return &client.ValidatorConfigJSON{
+		Op:        &config.Op.Value,
+		Threshold: &threshold,
+	}
+}
+
+func reportScheduleRecipients(recipients []ReportScheduleRecipient) *[]client.ReportRecipient {
+	result := []client.ReportRecipient{}
+
+	for _, recipient := range recipients {
+		target := recipient.Target.Value
+		result = append(result, client.ReportRecipient{
+			Type: client.ReportRecipientType(recipient.Type.Value),
+			RecipientConfigJson: &client.ReportRecipientConfigJSON{
+				Target: &target,
+			},
+		})
+	}
+
+	return &result
+}
+
+// reportScheduleFromResponse reads a schedule back from the API. Selected tabs
+// are not returned, so they are kept from prior.
+func reportScheduleFromResponse(prior ReportSchedule, report *client.ReportScheduleRestApiGet) (*ReportSchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := &ReportSchedule{
+		Id:              prior.Id,
+		Name:            types.String{Value: report.Name},
+		Description:     optionalStringFromPointer(report.Description, prior.Description),
+		Type:            types.String{Value: report.Type},
+		ChartId:         types.Int64{Null: true},
+		DashboardId:     types.Int64{Null: true},
+		Crontab:         types.String{Value: report.Crontab},
+		Timezone:        types.String{Null: true},
+		ReportFormat:    types.String{Null: true},
+		Recipients:      []ReportScheduleRecipient{},
+		Active:          types.Bool{Null: true},
+		GracePeriod:     types.Int64{Null: true},
+		WorkingTimeout:  types.Int64{Null: true},
+		LogRetention:    types.Int64{Null: true},
+		ForceScreenshot: types.Bool{Null: true},
+		SelectedTabs:    prior.SelectedTabs,
+		DatabaseId:      types.Int64{Null: true},
+		Sql:             types.String{Null: true},
+		ValidatorType:   types.String{Null: true},
+	}
+
+	if report.Database != nil && report.Database.Id != nil {
+		result.DatabaseId = types.Int64{Value: int64(*report.Database.Id)}
+	}
+
+	if report.Sql != nil && *report.Sql != "" {
+		result.Sql = types.String{Value: *report.Sql}
+	}
+
+	if report.ValidatorType != nil && *report.ValidatorType != "" {
+		result.ValidatorType = types.String{Value: *report.ValidatorType}
+	}
+
+	if result.ValidatorType.Value == "operator" && report.ValidatorConfigJson != nil {
+		var config client.ValidatorConfigJSON
+
+		err := json.Unmarshal([]byte(*report.ValidatorConfigJson), &config)
+
+		if err != nil {
+			diags.AddError(
+				"Error reading report schedule",
+				"Could not parse validator config, unexpected error: "+err.Error(),
+			)
+
+			return nil, diags
+		}
+
+		if config.Op != nil && config.Threshold != nil {
+			result.ValidatorConfig = &ReportScheduleValidatorConfig{
+				Op:        types.String{Value: *config.Op},
+				Threshold: types.Float64{Value: float64(*config.Threshold)},
+			}
+		}
+
+		// float32 loses precision, keep the configured threshold when it
+		// rounds to the same value
+		if result.ValidatorConfig != nil && prior.ValidatorConfig != nil && float32(prior.ValidatorConfig.Threshold.Value) == *config.Threshold {
+			result.ValidatorConfig.Threshold = prior.ValidatorConfig.Threshold
+		}
+	}
+
+	if report.Chart != nil && report.Chart.Id != nil {
+		result.ChartId = types.Int64{Value: int64(*report.Chart.Id)}
+	}
+
+	if report.Dashboard != nil && report.Dashboard.Id != nil {
+		result.DashboardId = types.Int64{Value: int64(*report.Dashboard.Id)}
+	}
+
+	if report.Timezone != nil {
+		result.Timezone = types.String{Value: *report.Timezone}
+	}
+
+	if report.ReportFormat != nil {
+		result.ReportFormat = types.String{Value: *report.ReportFormat}
+	}
+
+	if report.Active != nil {
+		result.Active = types.Bool{Value: *report.Active}
+	}
+
+	if report.GracePeriod != nil {
+		result.GracePeriod = types.Int64{Value: int64(*report.GracePeriod)}
+	}
+
+	if report.WorkingTimeout != nil {
+		result.WorkingTimeout = types.Int64{Value: int64(*report.WorkingTimeout)}
+	}
+
+	if report.LogRetention != nil {
+		result.LogRetention = types.Int64{Value: int64(*report.LogRetention)}
+	}
+
+	if report.ForceScreenshot != nil {
+		result.ForceScreenshot = types.Bool{Value: *report.ForceScreenshot}
+	}
+
+	for _, recipient := range report.Recipients {
+		var config client.ReportRecipientConfigJSON
+
+		if recipient.RecipientConfigJson != nil {
+			err := json.Unmarshal([]byte(*recipient.RecipientConfigJson), &config)
+
+			if err != nil {
+				diags.AddError(
+					"Error reading report schedule",
+					"Could not parse recipient config, unexpected error: "+err.Error(),
+				)
+
+				return nil, diags
+			}
+		}
+
+		target := types.String{Null: true}
+
+		if config.Target != nil {
+			target = types.String{Value: *config.Target}
+		}
+
+		result.Recipients = append(result.Recipients, ReportScheduleRecipient{
+			Type:   types.String{Value: recipient.Type},
+			Target: target,
+		})
+	}
+
+	return result, diags
+}
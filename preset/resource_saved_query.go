@@ -0,0 +1,262 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+type SavedQuery struct {
+	Id          types.Int64  `tfsdk:"id"`
+	DatabaseId  types.Int64  `tfsdk:"database_id"`
+	Schema      types.String `tfsdk:"schema"`
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+	Sql         types.String `tfsdk:"sql"`
+}
+
+type resourceSavedQueryType struct{}
+
+func (r resourceSavedQueryType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"database_id": {
+				Required: true,
+				Type:     types.Int64Type,
+			},
+			"schema": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"label": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"description": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"sql": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r resourceSavedQueryType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceSavedQuery{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceSavedQuery struct {
+	p presetProvider
+}
+
+func (r resourceSavedQuery) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var query SavedQuery
+	diags := req.Plan.Get(ctx, &query)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PostApiV1SavedQueryWithResponse(ctx, client.PostApiV1SavedQueryJSONRequestBody{
+		DbId:        int32Pointer(query.DatabaseId),
+		Schema:      stringPointer(query.Schema),
+		Label:       &query.Label.Value,
+		Description: stringPointer(query.Description),
+		Sql:         &query.Sql.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating saved query",
+			"Could not create saved query, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 201 {
+		resp.Diagnostics.AddError(
+			"Error creating saved query",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	query.Id = types.Int64{Value: int64(*res.JSON201.Id)}
+
+	diags = resp.State.Set(ctx, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSavedQuery) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SavedQuery
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.GetApiV1SavedQueryPkWithResponse(ctx, int(state.Id.Value), &client.GetApiV1SavedQueryPkParams{})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			"Could not read saved query, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	query := res.JSON200.Result
+	result := &SavedQuery{
+		Id:          state.Id,
+		DatabaseId:  types.Int64{Null: true},
+		Schema:      optionalStringFromPointer(query.Schema, state.Schema),
+		Label:       optionalStringFromPointer(query.Label, state.Label),
+		Description: optionalStringFromPointer(query.Description, state.Description),
+		Sql:         optionalStringFromPointer(query.Sql, state.Sql),
+	}
+
+	if query.Database != nil && query.Database.Id != nil {
+		result.DatabaseId = types.Int64{Value: int64(*query.Database.Id)}
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSavedQuery) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var query SavedQuery
+	diags := req.Plan.Get(ctx, &query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SavedQuery
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.PutApiV1SavedQueryPkWithResponse(ctx, int(state.Id.Value), client.PutApiV1SavedQueryPkJSONRequestBody{
+		DbId:        int32Pointer(query.DatabaseId),
+		Schema:      emptyStringPointer(query.Schema),
+		Label:       &query.Label.Value,
+		Description: emptyStringPointer(query.Description),
+		Sql:         &query.Sql.Value,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating saved query",
+			"Could not update saved query, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error updating saved query",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+
+	query.Id = state.Id
+
+	diags = resp.State.Set(ctx, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSavedQuery) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SavedQuery
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.p.client.DeleteApiV1SavedQueryPkWithResponse(ctx, int(state.Id.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting saved query",
+			"Could not delete saved query, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if res.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"Error deleting saved query",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts the saved query id.
+func (r resourceSavedQuery) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing saved query",
+			"Could not parse saved query id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
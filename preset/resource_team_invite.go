@@ -0,0 +1,276 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/manage"
+)
+
+type TeamInvite struct {
+	Id            types.String `tfsdk:"id"`
+	InviteId      types.Int64  `tfsdk:"invite_id"`
+	TeamName      types.String `tfsdk:"team_name"`
+	Email         types.String `tfsdk:"email"`
+	TeamRole      types.String `tfsdk:"team_role"`
+	WorkspaceId   types.Int64  `tfsdk:"workspace_id"`
+	WorkspaceRole types.String `tfsdk:"workspace_role"`
+	Status        types.String `tfsdk:"status"`
+}
+
+type resourceTeamInviteType struct{}
+
+func (r resourceTeamInviteType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Invites a user to a team, and optionally to a workspace. If the user already belongs to the team no invite is sent and the workspace role is assigned directly. Destroying an accepted invite does not remove the user from the team.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"invite_id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"team_name": {
+				Required:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"email": {
+				Required:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"team_role": {
+				Required:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(
+						"Admin",
+						"User",
+					),
+				},
+			},
+			"workspace_id": {
+				Optional:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"workspace_role": {
+				Optional:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(workspaceRoleNames()...),
+				},
+			},
+			"status": {
+				Computed:      true,
+				Type:          types.StringType,
+				Description:   "Either `pending` while the invite is open or `accepted` once the user has joined the team.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+		},
+	}, nil
+}
+
+func (r resourceTeamInviteType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceTeamInvite{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceTeamInvite struct {
+	p presetProvider
+}
+
+func (r resourceTeamInvite) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamInvite
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.WorkspaceId.Null != config.WorkspaceRole.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workspace_role"),
+			"Invalid workspace invite",
+			"workspace_id and workspace_role must be set together",
+		)
+	}
+}
+
+func (r resourceTeamInvite) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var invite TeamInvite
+	diags := req.Plan.Get(ctx, &invite)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.p.manageClient.GetTeamMemberByEmail(ctx, invite.TeamName.Value, invite.Email.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team invite",
+			"Could not read team members, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	invite.Id = types.String{Value: fmt.Sprintf("%s/%s", invite.TeamName.Value, invite.Email.Value)}
+
+	if member != nil {
+		if !invite.WorkspaceId.Null {
+			err = r.p.manageClient.SetWorkspaceRole(ctx, invite.TeamName.Value, invite.WorkspaceId.Value, member.User.Id, manage.WorkspaceRoles[invite.WorkspaceRole.Value])
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error creating team invite",
+					"Could not assign workspace role to existing team member, unexpected error: "+err.Error(),
+				)
+
+				return
+			}
+		}
+
+		invite.InviteId = types.Int64{Null: true}
+		invite.Status = types.String{Value: "accepted"}
+	} else {
+		body := manage.Invite{
+			Email:      invite.Email.Value,
+			TeamRoleId: manage.TeamRoles[invite.TeamRole.Value],
+		}
+
+		if !invite.WorkspaceId.Null {
+			roleIdentifier := manage.WorkspaceRoles[invite.WorkspaceRole.Value]
+			body.WorkspaceId = &invite.WorkspaceId.Value
+			body.WorkspaceRoleIdentifier = &roleIdentifier
+		}
+
+		created, err := r.p.manageClient.CreateInvite(ctx, invite.TeamName.Value, body)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating team invite",
+				"Could not create team invite, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+
+		invite.InviteId = types.Int64{Value: created.Id}
+		invite.Status = types.String{Value: "pending"}
+	}
+
+	diags = resp.State.Set(ctx, invite)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceTeamInvite) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamInvite
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, err := r.p.manageClient.GetInviteByEmail(ctx, state.TeamName.Value, state.Email.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team invite",
+			"Could not read team invites, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if invite != nil {
+		state.InviteId = types.Int64{Value: invite.Id}
+		state.Status = types.String{Value: "pending"}
+	} else {
+		member, err := r.p.manageClient.GetTeamMemberByEmail(ctx, state.TeamName.Value, state.Email.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading team invite",
+				"Could not read team members, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+
+		// the invite was revoked or expired without being accepted
+		if member == nil {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		state.Status = types.String{Value: "accepted"}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called with a changed invite because every configurable
+// attribute requires replacement.
+func (r resourceTeamInvite) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var invite TeamInvite
+	diags := req.Plan.Get(ctx, &invite)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, invite)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceTeamInvite) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamInvite
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.Value != "pending" {
+		return
+	}
+
+	err := r.p.manageClient.DeleteInvite(ctx, state.TeamName.Value, state.InviteId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting team invite",
+			"Could not delete team invite, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+}
@@ -0,0 +1,293 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-preset/manage"
+)
+
+type WorkspaceMembership struct {
+	Id          types.String `tfsdk:"id"`
+	TeamName    types.String `tfsdk:"team_name"`
+	WorkspaceId types.Int64  `tfsdk:"workspace_id"`
+	Email       types.String `tfsdk:"email"`
+	UserId      types.Int64  `tfsdk:"user_id"`
+	Role        types.String `tfsdk:"role"`
+}
+
+type resourceWorkspaceMembershipType struct{}
+
+func (r resourceWorkspaceMembershipType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"team_name": {
+				Required:      true,
+				Type:          types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"workspace_id": {
+				Required:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"email": {
+				Required:      true,
+				Type:          types.StringType,
+				Description:   "The email of an existing team member. Users that have not joined the team yet can be added with `preset_team_invite`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.RequiresReplace()},
+			},
+			"user_id": {
+				Computed:      true,
+				Type:          types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{resource.UseStateForUnknown()},
+			},
+			"role": {
+				Required: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(workspaceRoleNames()...),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceWorkspaceMembershipType) NewResource(_ context.Context, p provider.Provider) (resource.Resource, diag.Diagnostics) {
+	return resourceWorkspaceMembership{
+		p: *p.(*presetProvider),
+	}, nil
+}
+
+type resourceWorkspaceMembership struct {
+	p presetProvider
+}
+
+func (r resourceWorkspaceMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var membership WorkspaceMembership
+	diags := req.Plan.Get(ctx, &membership)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.p.manageClient.GetTeamMemberByEmail(ctx, membership.TeamName.Value, membership.Email.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workspace membership",
+			"Could not read team members, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if member == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Error creating workspace membership",
+			fmt.Sprintf("%s is not a member of team %s, invite them with preset_team_invite first", membership.Email.Value, membership.TeamName.Value),
+		)
+
+		return
+	}
+
+	err = r.p.manageClient.SetWorkspaceRole(ctx, membership.TeamName.Value, membership.WorkspaceId.Value, member.User.Id, manage.WorkspaceRoles[membership.Role.Value])
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workspace membership",
+			"Could not create workspace membership, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	membership.UserId = types.Int64{Value: member.User.Id}
+	membership.Id = types.String{Value: workspaceMembershipId(membership.TeamName.Value, membership.WorkspaceId.Value, member.User.Id)}
+
+	diags = resp.State.Set(ctx, membership)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceWorkspaceMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkspaceMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// imported memberships only know the email, so resolve the user first
+	if state.UserId.Null || state.UserId.Unknown {
+		member, err := r.p.manageClient.GetTeamMemberByEmail(ctx, state.TeamName.Value, state.Email.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading workspace membership",
+				"Could not read team members, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+
+		if member == nil {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		state.UserId = types.Int64{Value: member.User.Id}
+	}
+
+	membership, err := r.p.manageClient.GetWorkspaceMembership(ctx, state.TeamName.Value, state.WorkspaceId.Value, state.UserId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace membership",
+			"Could not read workspace membership, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if membership == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	result := &WorkspaceMembership{
+		Id:          types.String{Value: workspaceMembershipId(state.TeamName.Value, state.WorkspaceId.Value, membership.User.Id)},
+		TeamName:    state.TeamName,
+		WorkspaceId: state.WorkspaceId,
+		Email:       state.Email,
+		UserId:      types.Int64{Value: membership.User.Id},
+		Role:        types.String{Value: manage.WorkspaceRoleName(membership.WorkspaceRole.RoleIdentifier)},
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceWorkspaceMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var membership WorkspaceMembership
+	diags := req.Plan.Get(ctx, &membership)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WorkspaceMembership
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.p.manageClient.SetWorkspaceRole(ctx, state.TeamName.Value, state.WorkspaceId.Value, state.UserId.Value, manage.WorkspaceRoles[membership.Role.Value])
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workspace membership",
+			"Could not update workspace membership, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	membership.Id = state.Id
+	membership.UserId = state.UserId
+
+	diags = resp.State.Set(ctx, membership)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceWorkspaceMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkspaceMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.p.manageClient.DeleteWorkspaceMembership(ctx, state.TeamName.Value, state.WorkspaceId.Value, state.UserId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting workspace membership",
+			"Could not delete workspace membership, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ImportState accepts an id in the form team_name/workspace_id/email.
+func (r resourceWorkspaceMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Error importing workspace membership",
+			fmt.Sprintf("Expected an id in the form team_name/workspace_id/email, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	workspaceId, err := strconv.ParseInt(parts[1], 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing workspace membership",
+			"Could not parse workspace id: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[2])...)
+}
+
+func workspaceMembershipId(teamName string, workspaceId int64, userId int64) string {
+	return fmt.Sprintf("%s/%d/%d", teamName, workspaceId, userId)
+}
+
+func workspaceRoleNames() []string {
+	var names []string
+
+	for name := range manage.WorkspaceRoles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,188 @@
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate      = jsonStringType{}
+	_ tfsdk.AttributePlanModifier = jsonSemanticEqualityModifier{}
+)
+
+// jsonStringType is a string attribute holding a JSON document. Values are
+// plain types.String, so models need no changes, and invalid JSON is rejected
+// while the configuration is validated. Pair it with jsonSemanticEquality so
+// that formatting and key order do not produce diffs.
+type jsonStringType struct {
+	// object only accepts JSON objects, as form data and metadata must be
+	object bool
+}
+
+func (t jsonStringType) TerraformType(ctx context.Context) tftypes.Type {
+	return types.StringType.TerraformType(ctx)
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return types.StringType.ValueFromTerraform(ctx, in)
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+
+	return ok && other.object == t.object
+}
+
+func (t jsonStringType) String() string {
+	if t.object {
+		return "preset.jsonObjectType"
+	}
+
+	return "preset.jsonStringType"
+}
+
+func (t jsonStringType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return types.StringType.ApplyTerraform5AttributePathStep(step)
+}
+
+func (t jsonStringType) Validate(ctx context.Context, in tftypes.Value, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid JSON",
+			"Could not read value, unexpected error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	var document interface{}
+	err = json.Unmarshal([]byte(value), &document)
+
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid JSON",
+			fmt.Sprintf("Value must be a valid JSON document: %s.", err),
+		)
+
+		return diags
+	}
+
+	if _, ok := document.(map[string]interface{}); t.object && !ok {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid JSON",
+			"Value must be a JSON object.",
+		)
+	}
+
+	return diags
+}
+
+// jsonSemanticEquality keeps the prior state when the planned JSON only
+// differs in formatting or key order. Terraform accepts a planned value that
+// equals prior state in place of the configuration.
+func jsonSemanticEquality() jsonSemanticEqualityModifier {
+	return jsonSemanticEqualityModifier{}
+}
+
+type jsonSemanticEqualityModifier struct{}
+
+func (m jsonSemanticEqualityModifier) Description(ctx context.Context) string {
+	return "Ignores JSON formatting and key order changes"
+}
+
+func (m jsonSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return "Ignores JSON formatting and key order changes"
+}
+
+func (m jsonSemanticEqualityModifier) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	var state types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeState, &state)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	var plan types.String
+	diags = tfsdk.ValueAs(ctx, req.AttributePlan, &plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if state.Null || state.Unknown || plan.Null || plan.Unknown {
+		return
+	}
+
+	if isJsonEqual(state.Value, plan.Value) {
+		resp.AttributePlan = state
+	}
+}
+
+// isJsonEqual compares two JSON documents ignoring formatting and key order.
+// Invalid documents are never equal.
+func isJsonEqual(a string, b string) bool {
+	var documentA interface{}
+	if err := json.Unmarshal([]byte(a), &documentA); err != nil {
+		return false
+	}
+
+	var documentB interface{}
+	if err := json.Unmarshal([]byte(b), &documentB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(documentA, documentB)
+}
+
+// jsonStringFromResponse reads a JSON attribute back from a response, keeping
+// prior state when both documents are equivalent.
+func jsonStringFromResponse(v string, prior types.String) types.String {
+	if !prior.Null && !prior.Unknown && isJsonEqual(prior.Value, v) {
+		return prior
+	}
+
+	return types.String{Value: v}
+}
+
+// jsonStringFromPointer is jsonStringFromResponse for optional attributes.
+// Values that are null in prior state are not managed and stay null.
+func jsonStringFromPointer(v *string, prior types.String) types.String {
+	if prior.Null || v == nil {
+		return types.String{Null: true}
+	}
+
+	return jsonStringFromResponse(*v, prior)
+}
+
+// optionalJsonStringFromPointer is jsonStringFromResponse for optional
+// attributes that are cleared with an empty string, so that values set
+// outside of Terraform show up as drift.
+func optionalJsonStringFromPointer(v *string, prior types.String) types.String {
+	if v == nil || *v == "" {
+		return types.String{Null: true}
+	}
+
+	return jsonStringFromResponse(*v, prior)
+}
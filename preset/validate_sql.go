@@ -0,0 +1,85 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+// validateSql checks sql against the database engine and reports problems on
+// attributePath. Engines without a SQL validator are skipped, because Superset
+// only supports validation for a few of them. templateParams are used to render
+// Jinja templates in sql.
+func validateSql(ctx context.Context, c *client.ClientWithResponses, databaseId int64, schema *string, templateParams *map[string]interface{}, sql string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := c.PostApiV1DatabasePkValidateSqlWithResponse(ctx, int(databaseId), client.PostApiV1DatabasePkValidateSqlJSONRequestBody{
+		Schema:         schema,
+		Sql:            sql,
+		TemplateParams: templateParams,
+	})
+
+	if err != nil {
+		diags.AddAttributeWarning(
+			attributePath,
+			"Could not validate SQL",
+			"Could not validate SQL, unexpected error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	// returned when no validator is configured for the engine
+	if res.StatusCode() == 400 {
+		tflog.Debug(ctx, "Skipping SQL validation", map[string]interface{}{
+			"database_id": databaseId,
+			"response":    string(res.Body),
+		})
+
+		return diags
+	}
+
+	if res.StatusCode() != 200 {
+		diags.AddAttributeWarning(
+			attributePath,
+			"Could not validate SQL",
+			fmt.Sprintf("%v response returned: %v", res.StatusCode(), string(res.Body)),
+		)
+
+		return diags
+	}
+
+	if res.JSON200.Result == nil {
+		return diags
+	}
+
+	for _, sqlError := range *res.JSON200.Result {
+		message := ""
+
+		if sqlError.Message != nil {
+			message = *sqlError.Message
+		}
+
+		if sqlError.LineNumber != nil {
+			location := fmt.Sprintf("line %d", *sqlError.LineNumber)
+
+			if sqlError.StartColumn != nil {
+				location = fmt.Sprintf("%s, column %d", location, *sqlError.StartColumn)
+			}
+
+			message = fmt.Sprintf("%s: %s", location, message)
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid SQL",
+			message,
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,247 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/vercel/terraform-provider-preset/client"
+)
+
+// knownVizTypes are the chart plugins that ship with Superset.
+var knownVizTypes = []string{
+	"area",
+	"bar",
+	"big_number",
+	"big_number_total",
+	"box_plot",
+	"bubble",
+	"bubble_v2",
+	"bullet",
+	"cal_heatmap",
+	"chord",
+	"compare",
+	"country_map",
+	"deck_arc",
+	"deck_geojson",
+	"deck_grid",
+	"deck_hex",
+	"deck_multi",
+	"deck_path",
+	"deck_polygon",
+	"deck_scatter",
+	"deck_screengrid",
+	"dist_bar",
+	"dual_line",
+	"echarts_area",
+	"echarts_timeseries",
+	"echarts_timeseries_bar",
+	"echarts_timeseries_line",
+	"echarts_timeseries_scatter",
+	"echarts_timeseries_smooth",
+	"echarts_timeseries_step",
+	"event_flow",
+	"filter_box",
+	"funnel",
+	"gantt_chart",
+	"gauge_chart",
+	"graph_chart",
+	"handlebars",
+	"heatmap",
+	"heatmap_v2",
+	"histogram",
+	"histogram_v2",
+	"horizon",
+	"line",
+	"line_multi",
+	"mapbox",
+	"mixed_timeseries",
+	"paired_ttest",
+	"para",
+	"partition",
+	"pie",
+	"pivot_table",
+	"pivot_table_v2",
+	"pop_kpi",
+	"radar",
+	"rose",
+	"sankey",
+	"sankey_v2",
+	"sunburst",
+	"sunburst_v2",
+	"table",
+	"time_pivot",
+	"time_table",
+	"tree_chart",
+	"treemap",
+	"treemap_v2",
+	"waterfall",
+	"word_cloud",
+	"world_map",
+}
+
+// validateVizType checks vizType against the plugins that ship with Superset.
+// When lookup is set, unknown viz types are also looked up among the charts of
+// the workspace, so that plugins only installed in that workspace pass.
+// Superset has no endpoint listing the registered plugins: chart/_info only
+// describes the chart API itself.
+func validateVizType(ctx context.Context, c *client.ClientWithResponses, lookup bool, vizType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	candidates := knownVizTypes
+
+	for _, v := range candidates {
+		if v == vizType {
+			return diags
+		}
+	}
+
+	if lookup && c != nil {
+		workspaceVizTypes, err := getWorkspaceVizTypes(ctx, c)
+
+		if err != nil {
+			diags.AddAttributeWarning(
+				path.Root("viz_type"),
+				"Could not validate viz type",
+				"Could not read the viz types of the workspace, unexpected error: "+err.Error(),
+			)
+
+			return diags
+		}
+
+		for _, v := range workspaceVizTypes {
+			if v == vizType {
+				return diags
+			}
+		}
+
+		candidates = append(append([]string{}, candidates...), workspaceVizTypes...)
+	}
+
+	detail := fmt.Sprintf("%s is not a known viz type.", vizType)
+
+	if suggestion := closestString(vizType, candidates); suggestion != "" {
+		detail = fmt.Sprintf("%s Did you mean %s?", detail, suggestion)
+	}
+
+	if !lookup {
+		detail += " Set lookup_viz_types on the provider to accept viz types used by other charts of the workspace."
+	}
+
+	diags.AddAttributeError(
+		path.Root("viz_type"),
+		"Unknown viz type",
+		detail,
+	)
+
+	return diags
+}
+
+// getWorkspaceVizTypes pages through the charts of the workspace and returns
+// the distinct viz types in use.
+func getWorkspaceVizTypes(ctx context.Context, c *client.ClientWithResponses) ([]string, error) {
+	pageSize := 100
+	seen := map[string]bool{}
+
+	for page := 0; ; page++ {
+		currentPage := page
+		res, err := c.GetApiV1ChartWithResponse(ctx, &client.GetApiV1ChartParams{
+			Q: &client.GetListSchema{
+				Columns:  &[]string{"id", "viz_type"},
+				Page:     &currentPage,
+				PageSize: &pageSize,
+			},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode() != 200 {
+			return nil, fmt.Errorf("%v response returned: %v", res.StatusCode(), string(res.Body))
+		}
+
+		if res.JSON200.Result == nil {
+			break
+		}
+
+		for _, chart := range *res.JSON200.Result {
+			if chart.VizType != nil {
+				seen[*chart.VizType] = true
+			}
+		}
+
+		if len(*res.JSON200.Result) < pageSize {
+			break
+		}
+	}
+
+	result := []string{}
+
+	for vizType := range seen {
+		result = append(result, vizType)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// closestString returns the candidate with the smallest edit distance to s, or
+// an empty string when none is close enough to be a likely typo.
+func closestString(s string, candidates []string) string {
+	best := ""
+	bestDistance := len(s)/3 + 2
+
+	for _, candidate := range candidates {
+		distance := levenshtein(s, candidate)
+
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
+
+func levenshtein(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	result := values[0]
+
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,157 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type crontabField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+// crontabFields are the five fields accepted by Superset, which uses croniter
+// to parse schedules.
+var crontabFields = []crontabField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+var crontabMacros = map[string]struct{}{
+	"@yearly":   {},
+	"@annually": {},
+	"@monthly":  {},
+	"@weekly":   {},
+	"@daily":    {},
+	"@midnight": {},
+	"@hourly":   {},
+}
+
+func crontab() validatorCrontab {
+	return validatorCrontab{}
+}
+
+type validatorCrontab struct{}
+
+func (v validatorCrontab) Description(ctx context.Context) string {
+	return "Value must be a five field crontab expression"
+}
+
+func (v validatorCrontab) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a five field crontab expression, such as `0 9 * * mon-fri`"
+}
+
+func (v validatorCrontab) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var item types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &item)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	if item.Unknown || item.Null {
+		return
+	}
+
+	if err := parseCrontab(item.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid crontab provided",
+			fmt.Sprintf("%s, got: %s.", err, item.Value),
+		)
+		return
+	}
+}
+
+func parseCrontab(value string) error {
+	if _, ok := crontabMacros[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return nil
+	}
+
+	fields := strings.Fields(value)
+
+	if len(fields) != len(crontabFields) {
+		return fmt.Errorf("Crontab must have %d fields, found %d", len(crontabFields), len(fields))
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if err := parseCrontabPart(part, crontabFields[i]); err != nil {
+				return fmt.Errorf("Invalid %s field %q: %s", crontabFields[i].name, field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseCrontabPart checks a single list item, which is either `*`, a value or
+// a range, optionally followed by a `/step`.
+func parseCrontabPart(part string, field crontabField) error {
+	rangePart, step, hasStep := strings.Cut(part, "/")
+
+	if hasStep {
+		n, err := strconv.Atoi(step)
+
+		if err != nil || n < 1 {
+			return fmt.Errorf("step must be a positive number")
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	start, end, isRange := strings.Cut(rangePart, "-")
+
+	startValue, err := parseCrontabValue(start, field)
+
+	if err != nil {
+		return err
+	}
+
+	if !isRange {
+		return nil
+	}
+
+	endValue, err := parseCrontabValue(end, field)
+
+	if err != nil {
+		return err
+	}
+
+	if startValue > endValue {
+		return fmt.Errorf("range start %d is after range end %d", startValue, endValue)
+	}
+
+	return nil
+}
+
+func parseCrontabValue(value string, field crontabField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return field.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, field.min, field.max)
+	}
+
+	return n, nil
+}
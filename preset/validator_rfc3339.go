@@ -0,0 +1,45 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func rfc3339() validatorRfc3339 {
+	return validatorRfc3339{}
+}
+
+type validatorRfc3339 struct{}
+
+func (v validatorRfc3339) Description(ctx context.Context) string {
+	return "Value must be an RFC3339 timestamp"
+}
+
+func (v validatorRfc3339) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an RFC3339 timestamp, such as `2022-10-01T09:00:00Z`"
+}
+
+func (v validatorRfc3339) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var item types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &item)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	if item.Unknown || item.Null {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, item.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid timestamp provided",
+			fmt.Sprintf("Timestamp must be in RFC3339 format, got: %s.", item.Value),
+		)
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	// schedules are validated against the IANA database even when the host
+	// has no zoneinfo installed
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func timezone() validatorTimezone {
+	return validatorTimezone{}
+}
+
+type validatorTimezone struct{}
+
+func (v validatorTimezone) Description(ctx context.Context) string {
+	return "Value must be an IANA timezone name"
+}
+
+func (v validatorTimezone) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an IANA timezone name, such as `America/New_York`"
+}
+
+func (v validatorTimezone) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var item types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &item)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	if item.Unknown || item.Null {
+		return
+	}
+
+	// LoadLocation accepts "" and "Local", which are not timezone names
+	_, err := time.LoadLocation(item.Value)
+
+	if err != nil || item.Value == "" || item.Value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid timezone provided",
+			fmt.Sprintf("Timezone must be an IANA timezone name, got: %s.", item.Value),
+		)
+		return
+	}
+}
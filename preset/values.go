@@ -0,0 +1,100 @@
+package preset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringPointer returns nil for null values so that optional attributes are
+// omitted from requests instead of being cleared.
+func stringPointer(v types.String) *string {
+	if v.Null || v.Unknown {
+		return nil
+	}
+
+	return &v.Value
+}
+
+// int32SlicePointer converts a set of ids for a request. Null sets return nil
+// so that the field is omitted.
+func int32SlicePointer(ctx context.Context, v types.Set) (*[]int32, diag.Diagnostics) {
+	if v.Null || v.Unknown {
+		return nil, nil
+	}
+
+	var ids []int64
+	diags := v.ElementsAs(ctx, &ids, false)
+
+	result := []int32{}
+
+	for _, id := range ids {
+		result = append(result, int32(id))
+	}
+
+	return &result, diags
+}
+
+// int64SetFromIds reads a set of ids back from a response. Sets that are null
+// in prior state are not managed and stay null.
+func int64SetFromIds(ids []int64, prior types.Set) types.Set {
+	if prior.Null {
+		return types.Set{ElemType: types.Int64Type, Null: true}
+	}
+
+	elems := []attr.Value{}
+
+	for _, id := range ids {
+		elems = append(elems, types.Int64{Value: id})
+	}
+
+	return types.Set{ElemType: types.Int64Type, Elems: elems}
+}
+
+// int32Pointer returns nil for null values so that optional attributes are
+// omitted from requests.
+func int32Pointer(v types.Int64) *int32 {
+	if v.Null || v.Unknown {
+		return nil
+	}
+
+	result := int32(v.Value)
+
+	return &result
+}
+
+// boolPointer returns nil for null values so that optional attributes are
+// omitted from requests.
+func boolPointer(v types.Bool) *bool {
+	if v.Null || v.Unknown {
+		return nil
+	}
+
+	return &v.Value
+}
+
+// optionalStringFromPointer reads an optional attribute back from a response
+// so that changes made outside of Terraform show up as drift. Superset stores
+// unset strings as empty, which read back as null unless configured as empty.
+// Pair it with emptyStringPointer in updates.
+func optionalStringFromPointer(v *string, prior types.String) types.String {
+	if v == nil || (*v == "" && prior.Null) {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: *v}
+}
+
+// emptyStringPointer returns an empty string for null values so that removing
+// an optional attribute from the configuration clears it in Superset.
+func emptyStringPointer(v types.String) *string {
+	if v.Null || v.Unknown {
+		empty := ""
+
+		return &empty
+	}
+
+	return &v.Value
+}